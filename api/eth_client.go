@@ -28,6 +28,7 @@ type EthClient interface {
 	NonceAt(context.Context, common.Address, *big.Int) (uint64, error)
 	AssetBalanceAt(context.Context, common.Address, ids.ID, *big.Int) (*big.Int, error)
 	SuggestGasPrice(context.Context) (*big.Int, error)
+	ChainID(context.Context) (*big.Int, error)
 	AcceptedCodeAt(context.Context, common.Address) ([]byte, error)
 	AcceptedNonceAt(context.Context, common.Address) (uint64, error)
 	CodeAt(context.Context, common.Address, *big.Int) ([]byte, error)
@@ -179,6 +180,15 @@ func (c *ethClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
 	return c.client.SuggestGasPrice(ctx)
 }
 
+func (c *ethClient) ChainID(ctx context.Context) (*big.Int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.connect(); err != nil {
+		return nil, err
+	}
+	return c.client.ChainID(ctx)
+}
+
 func (c *ethClient) AcceptedCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -8,12 +8,14 @@ import (
 	"context"
 	"errors"
 	"io"
+	"os"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/ava-labs/avalanche-network-runner/local"
 	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"github.com/ava-labs/avalanche-network-runner/utils/artifacts"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -22,6 +24,10 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// size of the chunks of uploaded artifacts, under the default gRPC
+// message size limit
+const uploadChunkSize = 1024 * 1024
+
 type Config struct {
 	Endpoint    string
 	DialTimeout time.Duration
@@ -31,6 +37,7 @@ type Client interface {
 	Ping(ctx context.Context) (*rpcpb.PingResponse, error)
 	RPCVersion(ctx context.Context) (*rpcpb.RPCVersionResponse, error)
 	Start(ctx context.Context, execPath string, opts ...OpOption) (*rpcpb.StartResponse, error)
+	JoinExternalNetwork(ctx context.Context, execPath string, genesis string, networkID uint32, bootstrapPeers []*rpcpb.BootstrapPeer, opts ...OpOption) (*rpcpb.JoinExternalNetworkResponse, error)
 	CreateBlockchains(ctx context.Context, blockchainSpecs []*rpcpb.BlockchainSpec) (*rpcpb.CreateBlockchainsResponse, error)
 	CreateSubnets(ctx context.Context, subnetSpecs []*rpcpb.SubnetSpec) (*rpcpb.CreateSubnetsResponse, error)
 	Health(ctx context.Context) (*rpcpb.HealthResponse, error)
@@ -51,13 +58,39 @@ type Client interface {
 	LoadSnapshot(ctx context.Context, snapshotName string, opts ...OpOption) (*rpcpb.LoadSnapshotResponse, error)
 	RemoveSnapshot(ctx context.Context, snapshotName string) (*rpcpb.RemoveSnapshotResponse, error)
 	GetSnapshotNames(ctx context.Context) ([]string, error)
+	MigrateSnapshot(ctx context.Context, snapshotName string) (*rpcpb.MigrateSnapshotResponse, error)
+	RegisterBinary(ctx context.Context, name string, execPath string, opts ...OpOption) (*rpcpb.RegisterBinaryResponse, error)
+	ListBinaries(ctx context.Context) ([]*rpcpb.BinaryInfo, error)
+	RemoveBinary(ctx context.Context, name string) (*rpcpb.RemoveBinaryResponse, error)
+	UploadArtifact(ctx context.Context, path string) (*rpcpb.UploadArtifactResponse, error)
+	ReplaceVMPlugin(ctx context.Context, vmName string, pluginPath string) (*rpcpb.ReplaceVMPluginResponse, error)
+	UpdateChainConfig(ctx context.Context, chainID string, chainConfig string, perNodeChainConfig map[string]string) (*rpcpb.UpdateChainConfigResponse, error)
+	UpdateSubnetConfig(ctx context.Context, subnetID string, subnetConfig string) (*rpcpb.UpdateSubnetConfigResponse, error)
+	SetAddressState(ctx context.Context, address string, state string, remove bool) (*rpcpb.SetAddressStateResponse, error)
+	GetAddressState(ctx context.Context, address string) (*rpcpb.GetAddressStateResponse, error)
+	GetDepositOffers(ctx context.Context) (*rpcpb.GetDepositOffersResponse, error)
+	Deposit(ctx context.Context, depositOfferID string, amount uint64, duration uint32) (*rpcpb.DepositResponse, error)
+	ClaimRewards(ctx context.Context, depositTxIDs []string) (*rpcpb.ClaimRewardsResponse, error)
+	UnlockDeposit(ctx context.Context, depositTxIDs []string) (*rpcpb.UnlockDepositResponse, error)
+	CreateMultisigAlias(ctx context.Context, threshold uint32, addresses []string, memo string) (*rpcpb.CreateMultisigAliasResponse, error)
+	GrantCChainRole(ctx context.Context, address string, role string) (*rpcpb.CChainRoleResponse, error)
+	RevokeCChainRole(ctx context.Context, address string, role string) (*rpcpb.CChainRoleResponse, error)
+	GetCChainRoles(ctx context.Context, address string) (*rpcpb.GetCChainRolesResponse, error)
+	AddDelegator(ctx context.Context, nodeName string, amount uint64, duration time.Duration, rewardsOwner string, opts ...OpOption) (*rpcpb.AddDelegatorResponse, error)
+}
+
+// Conn is the connection a client issues its RPCs on.
+// *grpc.ClientConn satisfies it.
+type Conn interface {
+	grpc.ClientConnInterface
+	Close() error
 }
 
 type client struct {
 	cfg Config
 	log logging.Logger
 
-	conn *grpc.ClientConn
+	conn Conn
 
 	pingc    rpcpb.PingServiceClient
 	controlc rpcpb.ControlServiceClient
@@ -81,6 +114,12 @@ func New(cfg Config, log logging.Logger) (Client, error) {
 		return nil, err
 	}
 
+	return NewWithConn(cfg, conn, log), nil
+}
+
+// NewWithConn creates a client that issues its RPCs on [conn] instead of
+// dialing [cfg.Endpoint]. [conn] is closed when the client is closed.
+func NewWithConn(cfg Config, conn Conn, log logging.Logger) Client {
 	return &client{
 		cfg:      cfg,
 		log:      log,
@@ -88,7 +127,7 @@ func New(cfg Config, log logging.Logger) (Client, error) {
 		pingc:    rpcpb.NewPingServiceClient(conn),
 		controlc: rpcpb.NewControlServiceClient(conn),
 		closed:   make(chan struct{}),
-	}, nil
+	}
 }
 
 func (c *client) Ping(ctx context.Context) (*rpcpb.PingResponse, error) {
@@ -135,11 +174,86 @@ func (c *client) Start(ctx context.Context, execPath string, opts ...OpOption) (
 	}
 	req.ReassignPortsIfUsed = &ret.reassignPortsIfUsed
 	req.DynamicPorts = &ret.dynamicPorts
+	if ret.nodeNamespaces {
+		req.NodeNamespaces = &ret.nodeNamespaces
+	}
+	if ret.dedupSnapshots {
+		req.DedupSnapshots = &ret.dedupSnapshots
+	}
+	if ret.snapshotKeys {
+		req.SnapshotKeys = &ret.snapshotKeys
+	}
+	if len(ret.cChainPhases) > 0 {
+		req.CChainPhases = ret.cChainPhases
+	}
+	if ret.validatorBondAmount != 0 {
+		req.ValidatorBondAmount = &ret.validatorBondAmount
+	}
+	if ret.rewardsOwner != "" {
+		req.ValidatorRewardsOwner = &ret.rewardsOwner
+	}
+	if len(ret.nodeOwnerKeys) > 0 {
+		req.NodeOwnerKeys = ret.nodeOwnerKeys
+	}
+	if ret.depositOffers != "" {
+		req.DepositOffers = &ret.depositOffers
+	}
+	if len(ret.fundingKeys) > 0 {
+		req.FundingKeys = ret.fundingKeys
+	}
+	if ret.fundingKeyFile != "" {
+		req.FundingKeyFile = &ret.fundingKeyFile
+	}
+	if ret.adminKey != "" {
+		req.AdminKey = &ret.adminKey
+	}
+	if ret.portRangeStart != 0 || ret.portRangeEnd != 0 {
+		req.PortRangeStart = &ret.portRangeStart
+		req.PortRangeEnd = &ret.portRangeEnd
+	}
 
 	c.log.Info("start")
 	return c.controlc.Start(ctx, req)
 }
 
+// JoinExternalNetwork starts nodes joining the external network of
+// [genesis] and [networkID], by bootstrapping from [bootstrapPeers].
+// The nodes are managed like those of a started network.
+// One node joins unless WithNumNodes is given.
+func (c *client) JoinExternalNetwork(
+	ctx context.Context,
+	execPath string,
+	genesis string,
+	networkID uint32,
+	bootstrapPeers []*rpcpb.BootstrapPeer,
+	opts ...OpOption,
+) (*rpcpb.JoinExternalNetworkResponse, error) {
+	ret := &Op{numNodes: 1}
+	ret.applyOpts(opts)
+
+	req := &rpcpb.JoinExternalNetworkRequest{
+		ExecPath:       execPath,
+		NumNodes:       &ret.numNodes,
+		Genesis:        genesis,
+		NetworkId:      networkID,
+		BootstrapPeers: bootstrapPeers,
+		PluginDir:      ret.pluginDir,
+		ChainConfigs:   ret.chainConfigs,
+	}
+	if ret.trackSubnets != "" {
+		req.WhitelistedSubnets = &ret.trackSubnets
+	}
+	if ret.rootDataDir != "" {
+		req.RootDataDir = &ret.rootDataDir
+	}
+	if ret.globalNodeConfig != "" {
+		req.GlobalNodeConfig = &ret.globalNodeConfig
+	}
+
+	c.log.Info("join external network", zap.Uint32("network-id", networkID))
+	return c.controlc.JoinExternalNetwork(ctx, req)
+}
+
 func (c *client) CreateBlockchains(ctx context.Context, blockchainSpecs []*rpcpb.BlockchainSpec) (*rpcpb.CreateBlockchainsResponse, error) {
 	req := &rpcpb.CreateBlockchainsRequest{
 		BlockchainSpecs: blockchainSpecs,
@@ -245,6 +359,10 @@ func (c *client) AddNode(ctx context.Context, name string, execPath string, opts
 		ChainConfigs:   ret.chainConfigs,
 		UpgradeConfigs: ret.upgradeConfigs,
 		SubnetConfigs:  ret.subnetConfigs,
+		ValidatorSpec:  ret.validatorSpec,
+		Role:           ret.nodeRole,
+		BootstrapNodes: ret.bootstrapNodes,
+		BootstrapPeers: ret.bootstrapPeers,
 	}
 
 	if ret.pluginDir != "" {
@@ -352,6 +470,256 @@ func (c *client) GetSnapshotNames(ctx context.Context) ([]string, error) {
 	return resp.SnapshotNames, nil
 }
 
+func (c *client) MigrateSnapshot(ctx context.Context, snapshotName string) (*rpcpb.MigrateSnapshotResponse, error) {
+	c.log.Info("migrate snapshot", zap.String("snapshot-name", snapshotName))
+	return c.controlc.MigrateSnapshot(ctx, &rpcpb.MigrateSnapshotRequest{SnapshotName: snapshotName})
+}
+
+func (c *client) RegisterBinary(ctx context.Context, name string, execPath string, opts ...OpOption) (*rpcpb.RegisterBinaryResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	c.log.Info("register binary", zap.String("name", name), zap.String("exec-path", execPath))
+	return c.controlc.RegisterBinary(ctx, &rpcpb.RegisterBinaryRequest{
+		Name:      name,
+		ExecPath:  execPath,
+		PluginDir: ret.pluginDir,
+	})
+}
+
+func (c *client) ListBinaries(ctx context.Context) ([]*rpcpb.BinaryInfo, error) {
+	c.log.Info("list binaries")
+	resp, err := c.controlc.ListBinaries(ctx, &rpcpb.ListBinariesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Binaries, nil
+}
+
+func (c *client) RemoveBinary(ctx context.Context, name string) (*rpcpb.RemoveBinaryResponse, error) {
+	c.log.Info("remove binary", zap.String("name", name))
+	return c.controlc.RemoveBinary(ctx, &rpcpb.RemoveBinaryRequest{Name: name})
+}
+
+// ReplaceVMPlugin replaces the plugin of VM [vmName] on the nodes by the
+// plugin binary at server path [pluginPath], and restarts the nodes tracking
+// the subnets running the VM.
+func (c *client) ReplaceVMPlugin(ctx context.Context, vmName string, pluginPath string) (*rpcpb.ReplaceVMPluginResponse, error) {
+	c.log.Info("replace vm plugin", zap.String("vm-name", vmName), zap.String("plugin-path", pluginPath))
+	return c.controlc.ReplaceVMPlugin(ctx, &rpcpb.ReplaceVMPluginRequest{
+		VmName:     vmName,
+		PluginPath: pluginPath,
+	})
+}
+
+// UpdateChainConfig sets the config of blockchain [chainID] to [chainConfig],
+// or to the entry of [perNodeChainConfig] for the nodes in it, and restarts
+// the nodes whose config changed.
+func (c *client) UpdateChainConfig(
+	ctx context.Context,
+	chainID string,
+	chainConfig string,
+	perNodeChainConfig map[string]string,
+) (*rpcpb.UpdateChainConfigResponse, error) {
+	c.log.Info("update chain config", zap.String("chain-id", chainID))
+	return c.controlc.UpdateChainConfig(ctx, &rpcpb.UpdateChainConfigRequest{
+		ChainId:            chainID,
+		ChainConfig:        chainConfig,
+		PerNodeChainConfig: perNodeChainConfig,
+	})
+}
+
+// UpdateSubnetConfig sets the config of subnet [subnetID] to [subnetConfig],
+// and restarts the nodes whose config changed.
+func (c *client) UpdateSubnetConfig(ctx context.Context, subnetID string, subnetConfig string) (*rpcpb.UpdateSubnetConfigResponse, error) {
+	c.log.Info("update subnet config", zap.String("subnet-id", subnetID))
+	return c.controlc.UpdateSubnetConfig(ctx, &rpcpb.UpdateSubnetConfigRequest{
+		SubnetId:     subnetID,
+		SubnetConfig: subnetConfig,
+	})
+}
+
+// SetAddressState sets the address state named [state] on the P-chain address
+// [address], or unsets it if [remove] is true.
+func (c *client) SetAddressState(ctx context.Context, address string, state string, remove bool) (*rpcpb.SetAddressStateResponse, error) {
+	c.log.Info("set address state", zap.String("address", address), zap.String("state", state), zap.Bool("remove", remove))
+	return c.controlc.SetAddressState(ctx, &rpcpb.SetAddressStateRequest{
+		Address: address,
+		State:   state,
+		Remove:  remove,
+	})
+}
+
+// GetAddressState returns the address states of the P-chain address [address].
+func (c *client) GetAddressState(ctx context.Context, address string) (*rpcpb.GetAddressStateResponse, error) {
+	c.log.Info("get address state", zap.String("address", address))
+	return c.controlc.GetAddressState(ctx, &rpcpb.GetAddressStateRequest{
+		Address: address,
+	})
+}
+
+// GetDepositOffers returns the deposit offers active at the P-chain time.
+func (c *client) GetDepositOffers(ctx context.Context) (*rpcpb.GetDepositOffersResponse, error) {
+	c.log.Info("get deposit offers")
+	return c.controlc.GetDepositOffers(ctx, &rpcpb.GetDepositOffersRequest{})
+}
+
+// Deposit deposits [amount] nAVAX of the funding address for [duration] seconds
+// with the deposit offer [depositOfferID].
+func (c *client) Deposit(ctx context.Context, depositOfferID string, amount uint64, duration uint32) (*rpcpb.DepositResponse, error) {
+	c.log.Info("deposit", zap.String("deposit-offer-id", depositOfferID), zap.Uint64("amount", amount), zap.Uint32("duration", duration))
+	return c.controlc.Deposit(ctx, &rpcpb.DepositRequest{
+		DepositOfferId: depositOfferID,
+		Amount:         amount,
+		Duration:       duration,
+	})
+}
+
+// ClaimRewards claims the rewards of the deposits [depositTxIDs], along with the
+// validator and expired deposit rewards of the funding address.
+func (c *client) ClaimRewards(ctx context.Context, depositTxIDs []string) (*rpcpb.ClaimRewardsResponse, error) {
+	c.log.Info("claim rewards", zap.Strings("deposit-tx-ids", depositTxIDs))
+	return c.controlc.ClaimRewards(ctx, &rpcpb.ClaimRewardsRequest{
+		DepositTxIds: depositTxIDs,
+	})
+}
+
+// UnlockDeposit unlocks the unlockable amount of the deposits [depositTxIDs].
+func (c *client) UnlockDeposit(ctx context.Context, depositTxIDs []string) (*rpcpb.UnlockDepositResponse, error) {
+	c.log.Info("unlock deposit", zap.Strings("deposit-tx-ids", depositTxIDs))
+	return c.controlc.UnlockDeposit(ctx, &rpcpb.UnlockDepositRequest{
+		DepositTxIds: depositTxIDs,
+	})
+}
+
+// CreateMultisigAlias creates a multisig alias owned by [threshold] of the
+// P-chain addresses [addresses].
+func (c *client) CreateMultisigAlias(ctx context.Context, threshold uint32, addresses []string, memo string) (*rpcpb.CreateMultisigAliasResponse, error) {
+	c.log.Info("create multisig alias", zap.Uint32("threshold", threshold), zap.Strings("addresses", addresses))
+	return c.controlc.CreateMultisigAlias(ctx, &rpcpb.CreateMultisigAliasRequest{
+		Threshold: threshold,
+		Addresses: addresses,
+		Memo:      memo,
+	})
+}
+
+// GrantCChainRole grants the C-chain role named [role] to the C-chain
+// address [address].
+func (c *client) GrantCChainRole(ctx context.Context, address string, role string) (*rpcpb.CChainRoleResponse, error) {
+	c.log.Info("grant C-chain role", zap.String("address", address), zap.String("role", role))
+	return c.controlc.GrantCChainRole(ctx, &rpcpb.CChainRoleRequest{
+		Address: address,
+		Role:    role,
+	})
+}
+
+// RevokeCChainRole revokes the C-chain role named [role] from the C-chain
+// address [address].
+func (c *client) RevokeCChainRole(ctx context.Context, address string, role string) (*rpcpb.CChainRoleResponse, error) {
+	c.log.Info("revoke C-chain role", zap.String("address", address), zap.String("role", role))
+	return c.controlc.RevokeCChainRole(ctx, &rpcpb.CChainRoleRequest{
+		Address: address,
+		Role:    role,
+	})
+}
+
+// GetCChainRoles returns the C-chain roles of the C-chain address [address].
+func (c *client) GetCChainRoles(ctx context.Context, address string) (*rpcpb.GetCChainRolesResponse, error) {
+	c.log.Info("get C-chain roles", zap.String("address", address))
+	return c.controlc.GetCChainRoles(ctx, &rpcpb.GetCChainRolesRequest{
+		Address: address,
+	})
+}
+
+// AddDelegator delegates [amount] to the validator [nodeName] until [duration]
+// from now, with the rewards going to [rewardsOwner]. Zero values mean the
+// minimum delegator stake, the validation end and the first funding address.
+// The primary network validation is delegated to, unless an elastic subnet
+// is given with WithDelegationSubnet.
+func (c *client) AddDelegator(
+	ctx context.Context,
+	nodeName string,
+	amount uint64,
+	duration time.Duration,
+	rewardsOwner string,
+	opts ...OpOption,
+) (*rpcpb.AddDelegatorResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	req := &rpcpb.AddDelegatorRequest{
+		NodeName:     nodeName,
+		Amount:       amount,
+		RewardsOwner: rewardsOwner,
+	}
+	if duration != 0 {
+		req.Duration = duration.String()
+	}
+	if ret.delegationSubnetID != "" {
+		req.SubnetId = &ret.delegationSubnetID
+	}
+
+	c.log.Info("add delegator", zap.String("node-name", nodeName), zap.Uint64("amount", amount))
+	return c.controlc.AddDelegator(ctx, req)
+}
+
+// UploadArtifact uploads the file or dir at [path] to the server.
+// The returned reference can be given in place of a server path in later
+// requests, e.g. as exec path, plugin dir or blockchain genesis.
+func (c *client) UploadArtifact(ctx context.Context, path string) (*rpcpb.UploadArtifactResponse, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Info("upload artifact", zap.String("path", path), zap.Bool("is-dir", info.IsDir()))
+
+	var r io.ReadCloser
+	if info.IsDir() {
+		pr, pw := io.Pipe()
+		go func() {
+			pw.CloseWithError(artifacts.WriteDirArchive(pw, path))
+		}()
+		r = pr
+	} else {
+		r, err = os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer r.Close()
+
+	stream, err := c.controlc.UploadArtifact(ctx)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, uploadChunkSize)
+	// the first message is sent even if empty, to give the kind of contents
+	for first := true; ; first = false {
+		n, readErr := io.ReadFull(r, buf)
+		if n > 0 || first {
+			err := stream.Send(&rpcpb.UploadArtifactRequest{
+				Chunk: buf[:n],
+				IsDir: info.IsDir(),
+			})
+			if errors.Is(err, io.EOF) {
+				// the server failed, its error is returned by CloseAndRecv
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+			break
+		}
+		if readErr != nil {
+			_ = stream.CloseSend()
+			return nil, readErr
+		}
+	}
+	return stream.CloseAndRecv()
+}
+
 func (c *client) Close() error {
 	c.closeOnce.Do(func() {
 		close(c.closed)
@@ -374,6 +742,24 @@ type Op struct {
 	subnetConfigs       map[string]string
 	reassignPortsIfUsed bool
 	dynamicPorts        bool
+	portRangeStart      uint32
+	portRangeEnd        uint32
+	nodeNamespaces      bool
+	dedupSnapshots      bool
+	snapshotKeys        bool
+	cChainPhases        map[string]string
+	validatorBondAmount uint64
+	rewardsOwner        string
+	nodeOwnerKeys       []string
+	depositOffers       string
+	fundingKeys         []string
+	fundingKeyFile      string
+	adminKey            string
+	validatorSpec       *rpcpb.ValidatorSpec
+	delegationSubnetID  string
+	nodeRole            string
+	bootstrapNodes      []string
+	bootstrapPeers      []*rpcpb.BootstrapPeer
 }
 
 type OpOption func(*Op)
@@ -479,6 +865,137 @@ func WithDynamicPorts(dynamicPorts bool) OpOption {
 	}
 }
 
+// WithNodeNamespaces runs each node in its own network namespace with a
+// distinct IP. Linux only, requires root.
+func WithNodeNamespaces(nodeNamespaces bool) OpOption {
+	return func(op *Op) {
+		op.nodeNamespaces = nodeNamespaces
+	}
+}
+
+// WithDedupSnapshots saves the snapshots of the network to a deduplicated,
+// compressed store shared by all snapshots.
+func WithDedupSnapshots(dedupSnapshots bool) OpOption {
+	return func(op *Op) {
+		op.dedupSnapshots = dedupSnapshots
+	}
+}
+
+// WithSnapshotKeys saves the private keys given inline, funding, subnet
+// owner, node owner and admin keys, in the snapshots of the network.
+// Otherwise only the path of the funding key file is saved.
+func WithSnapshotKeys(snapshotKeys bool) OpOption {
+	return func(op *Op) {
+		op.snapshotKeys = snapshotKeys
+	}
+}
+
+// WithUpgradeSchedule sets the activation times of the C-chain phases,
+// named by their coreth chain config timestamp.
+// Times are in RFC3339 format, or offsets from the network start such as "10m".
+func WithUpgradeSchedule(cChainPhases map[string]string) OpOption {
+	return func(op *Op) {
+		op.cChainPhases = cChainPhases
+	}
+}
+
+// WithValidatorBond sets the nAVAX bonded by each node added as primary
+// network validator, and the P-chain address receiving their rewards.
+// A zero amount means the minimum validator stake, and an empty owner
+// the funding address.
+func WithValidatorBond(amount uint64, rewardsOwner string) OpOption {
+	return func(op *Op) {
+		op.validatorBondAmount = amount
+		op.rewardsOwner = rewardsOwner
+	}
+}
+
+// WithNodeOwnerKeys sets the private keys of the consortium members the
+// added validators are registered to, on networks locking stakes as bonds,
+// in "PrivateKey-..." format. Each of them owns a single node.
+// If not given, the funding keys.
+func WithNodeOwnerKeys(nodeOwnerKeys []string) OpOption {
+	return func(op *Op) {
+		op.nodeOwnerKeys = nodeOwnerKeys
+	}
+}
+
+// WithDepositOffers adds the JSON list [depositOffers] to the deposit offers of
+// the camino genesis, in its "depositOffers" format.
+func WithDepositOffers(depositOffers string) OpOption {
+	return func(op *Op) {
+		op.depositOffers = depositOffers
+	}
+}
+
+// WithFundingKeys sets the private keys funding the P-chain txs of the
+// runner, in "PrivateKey-..." format, and the server path of a file with
+// further ones, one per line. The first key receives the change.
+func WithFundingKeys(fundingKeys []string, fundingKeyFile string) OpOption {
+	return func(op *Op) {
+		op.fundingKeys = fundingKeys
+		op.fundingKeyFile = fundingKeyFile
+	}
+}
+
+// WithAdminKey sets the private key holding the admin role of the P-chain
+// and the C-chain, in "PrivateKey-..." format, which the runner signs the
+// admin txs with.
+func WithAdminKey(adminKey string) OpOption {
+	return func(op *Op) {
+		op.adminKey = adminKey
+	}
+}
+
+// WithValidatorSpec sets the stake, delegation fee and duration of the
+// primary network validation of an added node.
+func WithValidatorSpec(validatorSpec *rpcpb.ValidatorSpec) OpOption {
+	return func(op *Op) {
+		op.validatorSpec = validatorSpec
+	}
+}
+
+// WithNodeRole sets the role of an added node: "validator", "api" or
+// "archival".
+func WithNodeRole(role string) OpOption {
+	return func(op *Op) {
+		op.nodeRole = role
+	}
+}
+
+// WithBootstrapNodes sets the running nodes an added node bootstraps from,
+// instead of the beacons of the network.
+func WithBootstrapNodes(nodeNames []string) OpOption {
+	return func(op *Op) {
+		op.bootstrapNodes = nodeNames
+	}
+}
+
+// WithBootstrapPeers sets peers outside the network an added node bootstraps
+// from, besides its bootstrap nodes.
+func WithBootstrapPeers(peers []*rpcpb.BootstrapPeer) OpOption {
+	return func(op *Op) {
+		op.bootstrapPeers = peers
+	}
+}
+
+// WithDelegationSubnet sets the elastic subnet delegated to by AddDelegator,
+// instead of the primary network.
+func WithDelegationSubnet(subnetID string) OpOption {
+	return func(op *Op) {
+		op.delegationSubnetID = subnetID
+	}
+}
+
+// WithPortRange sets the inclusive range to allocate node ports from,
+// if not given in node configs.
+func WithPortRange(start uint32, end uint32) OpOption {
+	return func(op *Op) {
+		op.portRangeStart = start
+		op.portRangeEnd = end
+	}
+}
+
 func isClientCanceled(ctxErr error, err error) bool {
 	if ctxErr != nil {
 		return true
@@ -0,0 +1,1669 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package fake implements an in-memory ControlService for unit tests of
+// code that consumes [client.Client].
+//
+// No node is ever started. The fake keeps a consistent ClusterInfo across
+// calls, returns the same domain errors as the real server, and lets tests
+// inject errors per RPC and inspect the requests it received.
+package fake
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/client"
+	"github.com/ava-labs/avalanche-network-runner/client/inproc"
+	"github.com/ava-labs/avalanche-network-runner/local"
+	"github.com/ava-labs/avalanche-network-runner/local/binaries"
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"github.com/ava-labs/avalanche-network-runner/server"
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanche-network-runner/utils/artifacts"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// Root data dir reported if the request gives none.
+	DefaultRootDataDir = "/tmp/network-runner-root-data_fake"
+	// Snapshot paths are reported under this dir.
+	SnapshotsDir = "/tmp/network-runner-snapshots_fake"
+	// Registered binaries are reported under this dir.
+	BinariesDir = "/tmp/network-runner-binaries_fake"
+
+	firstAPIPort   = 9650
+	snapshotPrefix = "anr-snapshot-"
+	// delegated when no amount is given, in nAVAX
+	minDelegatorStake = 25_000_000_000
+	// of delegations without duration, instead of until the validation end
+	defaultDelegationDuration = 365 * 24 * time.Hour
+)
+
+var (
+	_ rpcpb.PingServiceServer    = (*Server)(nil)
+	_ rpcpb.ControlServiceServer = (*Server)(nil)
+
+	ErrNodeExists       = errors.New("node already exists")
+	ErrSnapshotExists   = errors.New("snapshot already exists")
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+	ErrSubnetNotFound   = errors.New("subnet not found")
+	ErrDepositNotFound  = errors.New("deposit not found")
+
+	// initialAdmin of the default C-chain genesis
+	genesisCChainAdmin = common.HexToAddress("0x8db97C7cEcE249c2b98bDC0226Cc4C2A57BF52FC")
+)
+
+// Server is a scriptable in-memory implementation of the
+// PingService and ControlService APIs. It is safe for concurrent use.
+// It can be used through [NewClient], or registered on a *grpc.Server.
+type Server struct {
+	mu sync.Mutex
+
+	// nil if no network is running
+	clusterInfo *rpcpb.ClusterInfo
+	// last cluster info, kept after Stop as the real server does
+	lastClusterInfo *rpcpb.ClusterInfo
+	// snapshot name --> cluster info when saved
+	snapshots map[string]*rpcpb.ClusterInfo
+	// binary name --> registered binary
+	binaries map[string]*rpcpb.BinaryInfo
+	// next API port to assign
+	nextPort uint32
+	// to generate deterministic IDs
+	nextID uint64
+	// P-chain address --> address state bits set since the network start
+	addressStates map[string]uint64
+	// genesis deposit offers, started at the network start
+	depositOffers []*deposit.Offer
+	// deposit tx ID --> deposit
+	deposits map[ids.ID]*deposit.Deposit
+	// C-chain address --> C-chain role bits, starting with the genesis admin
+	cChainRoles map[common.Address]uint64
+	// C-chain addresses allowed to deploy contracts
+	cChainDeployers map[common.Address]bool
+
+	// RPC name --> injected errors
+	errs map[string]*injectedErr
+	// RPC name --> requests received, in order
+	requests map[string][]proto.Message
+
+	rpcpb.UnimplementedPingServiceServer
+	rpcpb.UnimplementedControlServiceServer
+}
+
+type injectedErr struct {
+	err error
+	// number of calls left to fail, or negative to fail forever
+	times int
+}
+
+func NewServer() *Server {
+	return &Server{
+		snapshots: map[string]*rpcpb.ClusterInfo{},
+		binaries:  map[string]*rpcpb.BinaryInfo{},
+		nextPort:  firstAPIPort,
+		errs:      map[string]*injectedErr{},
+		requests:  map[string][]proto.Message{},
+	}
+}
+
+// NewClient returns a client talking to [s] in-process.
+func NewClient(s *Server, log logging.Logger) client.Client {
+	conn := inproc.NewConn(
+		s,
+		nil,
+		&rpcpb.PingService_ServiceDesc,
+		&rpcpb.ControlService_ServiceDesc,
+	)
+	return client.NewWithConn(client.Config{Endpoint: "fake"}, conn, log)
+}
+
+// SetError makes every following call to RPC [method] (e.g. "Start")
+// fail with [err], until cleared by passing a nil [err].
+func (s *Server) SetError(method string, err error) {
+	s.FailNext(method, err, -1)
+}
+
+// FailNext makes the next [times] calls to RPC [method] fail with [err].
+// A negative [times] fails all following calls.
+func (s *Server) FailNext(method string, err error, times int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err == nil || times == 0 {
+		delete(s.errs, method)
+		return
+	}
+	s.errs[method] = &injectedErr{err: err, times: times}
+}
+
+// Requests returns copies of the requests received by RPC [method],
+// including those failed by injected errors.
+func (s *Server) Requests(method string) []proto.Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reqs := make([]proto.Message, len(s.requests[method]))
+	for i, req := range s.requests[method] {
+		reqs[i] = proto.Clone(req)
+	}
+	return reqs
+}
+
+// Calls returns the number of calls received by RPC [method].
+func (s *Server) Calls(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.requests[method])
+}
+
+// ClusterInfo returns a copy of the cluster info of the running network,
+// or nil if there is none.
+func (s *Server) ClusterInfo() *rpcpb.ClusterInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.copyClusterInfo()
+}
+
+// Update calls [f] on the cluster info of the running network, e.g. to
+// script a node becoming unhealthy. Returns server.ErrNotBootstrapped
+// if there is no network.
+func (s *Server) Update(f func(*rpcpb.ClusterInfo)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.clusterInfo == nil {
+		return server.ErrNotBootstrapped
+	}
+	f(s.clusterInfo)
+	return nil
+}
+
+// Records [req] and returns the error injected for [method], if any.
+// Assumes [s.mu] is held.
+func (s *Server) call(method string, req proto.Message) error {
+	s.requests[method] = append(s.requests[method], proto.Clone(req))
+	injected, ok := s.errs[method]
+	if !ok {
+		return nil
+	}
+	if injected.times > 0 {
+		injected.times--
+		if injected.times == 0 {
+			delete(s.errs, method)
+		}
+	}
+	return injected.err
+}
+
+// Assumes [s.mu] is held.
+func (s *Server) copyClusterInfo() *rpcpb.ClusterInfo {
+	if s.clusterInfo == nil {
+		return nil
+	}
+	return proto.Clone(s.clusterInfo).(*rpcpb.ClusterInfo)
+}
+
+// Returns a new deterministic ID.
+// Assumes [s.mu] is held.
+func (s *Server) newID() ids.ID {
+	s.nextID++
+	return ids.ID(sha256.Sum256([]byte(fmt.Sprintf("fake-%d", s.nextID))))
+}
+
+// Returns a new deterministic node ID.
+// Assumes [s.mu] is held.
+func (s *Server) newNodeID() ids.NodeID {
+	id := s.newID()
+	nodeID := ids.NodeID{}
+	copy(nodeID[:], id[:])
+	return nodeID
+}
+
+// Returns the stored binary and plugin dir if [execPath] is the name of a
+// registered binary, as the real server does.
+// Assumes [s.mu] is held.
+func (s *Server) resolveBinary(execPath string, pluginDir string) (string, string) {
+	binary, ok := s.binaries[execPath]
+	if !ok {
+		return execPath, pluginDir
+	}
+	if pluginDir == "" {
+		pluginDir = binary.PluginDir
+	}
+	return binary.ExecPath, pluginDir
+}
+
+// Assumes [s.mu] is held.
+func (s *Server) newNodeInfo(name string, execPath string, pluginDir string, trackSubnets string, config string) *rpcpb.NodeInfo {
+	nodeDir := filepath.Join(s.clusterInfo.RootDataDir, name)
+	port := s.nextPort
+	s.nextPort += 2
+	return &rpcpb.NodeInfo{
+		Name:               name,
+		ExecPath:           execPath,
+		Uri:                fmt.Sprintf("http://127.0.0.1:%d", port),
+		Id:                 s.newNodeID().String(),
+		LogDir:             filepath.Join(nodeDir, "logs"),
+		DbDir:              filepath.Join(nodeDir, "db"),
+		PluginDir:          pluginDir,
+		WhitelistedSubnets: trackSubnets,
+		Config:             []byte(config),
+		Role:               node.ValidatorRole.String(),
+	}
+}
+
+// Keeps [s.clusterInfo.NodeNames] in sync with its node infos.
+// Assumes [s.mu] is held.
+func (s *Server) updateNodeNames() {
+	s.clusterInfo.NodeNames = maps.Keys(s.clusterInfo.NodeInfos)
+	sort.Strings(s.clusterInfo.NodeNames)
+}
+
+// Returns the node info of [name].
+// Assumes [s.mu] is held and there is a network.
+func (s *Server) getNode(name string) (*rpcpb.NodeInfo, error) {
+	nodeInfo, ok := s.clusterInfo.NodeInfos[name]
+	if !ok {
+		return nil, server.ErrNodeNotFound
+	}
+	return nodeInfo, nil
+}
+
+// Creates a subnet validated by the participants of [spec], or all validator
+// nodes if none. Its owner and participant weights are validated, but not kept.
+// Assumes [s.mu] is held and there is a network.
+func (s *Server) createSubnet(spec *rpcpb.SubnetSpec) (string, error) {
+	participants, owner := spec.GetParticipants(), spec.GetOwner()
+	weights := network.SubnetSpec{Participants: participants, ParticipantWeights: spec.GetParticipantWeights()}
+	if err := weights.ValidateParticipantWeights(); err != nil {
+		return "", err
+	}
+	if owner != nil {
+		subnetOwner := network.SubnetOwner{
+			Threshold: owner.Threshold,
+			Keys:      owner.Keys,
+			Addresses: owner.Addresses,
+		}
+		if err := subnetOwner.Validate(); err != nil {
+			return "", err
+		}
+	}
+	if len(participants) == 0 {
+		for _, nodeName := range s.clusterInfo.NodeNames {
+			if s.clusterInfo.NodeInfos[nodeName].Role == node.ValidatorRole.String() {
+				participants = append(participants, nodeName)
+			}
+		}
+	}
+	for _, nodeName := range participants {
+		nodeInfo, err := s.getNode(nodeName)
+		if err != nil {
+			return "", err
+		}
+		if nodeInfo.Role != node.ValidatorRole.String() {
+			return "", fmt.Errorf("subnet participant %q is an %s node, not a validator", nodeName, nodeInfo.Role)
+		}
+	}
+	subnetID := s.newID().String()
+	s.clusterInfo.Subnets = append(s.clusterInfo.Subnets, subnetID)
+	if s.clusterInfo.SubnetParticipants == nil {
+		s.clusterInfo.SubnetParticipants = map[string]*rpcpb.SubnetParticipants{}
+	}
+	s.clusterInfo.SubnetParticipants[subnetID] = &rpcpb.SubnetParticipants{
+		NodeNames: append([]string{}, participants...),
+	}
+	return subnetID, nil
+}
+
+// Assumes [s.mu] is held and there is a network.
+func (s *Server) createBlockchains(specs []*rpcpb.BlockchainSpec) ([]string, error) {
+	chainIDs := []string{}
+	for _, spec := range specs {
+		vmID, err := utils.VMID(spec.VmName)
+		if err != nil {
+			return nil, err
+		}
+		var subnetID string
+		switch {
+		case spec.SubnetId != nil:
+			subnetID = *spec.SubnetId
+			if _, ok := s.clusterInfo.SubnetParticipants[subnetID]; !ok {
+				return nil, fmt.Errorf("%w: %q", ErrSubnetNotFound, subnetID)
+			}
+		default:
+			subnetID, err = s.createSubnet(spec.GetSubnetSpec())
+			if err != nil {
+				return nil, err
+			}
+		}
+		chainID := s.newID().String()
+		if s.clusterInfo.CustomChains == nil {
+			s.clusterInfo.CustomChains = map[string]*rpcpb.CustomChainInfo{}
+		}
+		s.clusterInfo.CustomChains[chainID] = &rpcpb.CustomChainInfo{
+			ChainName: spec.VmName,
+			VmId:      vmID.String(),
+			SubnetId:  subnetID,
+			ChainId:   chainID,
+		}
+		chainIDs = append(chainIDs, chainID)
+	}
+	return chainIDs, nil
+}
+
+func (s *Server) Ping(_ context.Context, req *rpcpb.PingRequest) (*rpcpb.PingResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("Ping", req); err != nil {
+		return nil, err
+	}
+	return &rpcpb.PingResponse{Pid: int32(os.Getpid())}, nil
+}
+
+func (s *Server) RPCVersion(_ context.Context, req *rpcpb.RPCVersionRequest) (*rpcpb.RPCVersionResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("RPCVersion", req); err != nil {
+		return nil, err
+	}
+	return &rpcpb.RPCVersionResponse{Version: server.RPCVersion}, nil
+}
+
+func (s *Server) Start(_ context.Context, req *rpcpb.StartRequest) (*rpcpb.StartResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("Start", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo != nil {
+		return nil, server.ErrAlreadyBootstrapped
+	}
+	numNodes := server.DefaultNodes
+	if req.NumNodes != nil {
+		numNodes = req.GetNumNodes()
+	}
+	if numNodes < server.MinNodes {
+		return nil, server.ErrNotEnoughNodesForStart
+	}
+	execPath, pluginDir := s.resolveBinary(req.GetExecPath(), req.GetPluginDir())
+	if err := utils.CheckExecPath(execPath); errors.Is(err, utils.ErrInvalidExecPath) {
+		return nil, err
+	}
+	rootDataDir := req.GetRootDataDir()
+	if rootDataDir == "" {
+		rootDataDir = DefaultRootDataDir
+	}
+
+	s.clusterInfo = &rpcpb.ClusterInfo{
+		Pid:         int32(os.Getpid()),
+		RootDataDir: rootDataDir,
+		NodeInfos:   map[string]*rpcpb.NodeInfo{},
+	}
+	nodeNames := []string{}
+	if len(req.CustomNodeConfigs) > 0 {
+		nodeNames = maps.Keys(req.CustomNodeConfigs)
+		sort.Strings(nodeNames)
+	} else {
+		for i := uint32(1); i <= numNodes; i++ {
+			nodeNames = append(nodeNames, fmt.Sprintf("node%d", i))
+		}
+	}
+	for _, nodeName := range nodeNames {
+		s.clusterInfo.NodeInfos[nodeName] = s.newNodeInfo(
+			nodeName,
+			execPath,
+			pluginDir,
+			req.GetWhitelistedSubnets(),
+			req.CustomNodeConfigs[nodeName],
+		)
+	}
+	s.updateNodeNames()
+	s.addressStates = map[string]uint64{}
+	s.cChainRoles = map[common.Address]uint64{genesisCChainAdmin: network.CChainRoles["admin"]}
+	s.cChainDeployers = map[common.Address]bool{}
+	validatorBond := network.ValidatorBond{
+		Amount:        req.GetValidatorBondAmount(),
+		RewardsOwner:  req.GetValidatorRewardsOwner(),
+		NodeOwnerKeys: req.NodeOwnerKeys,
+	}
+	if err := validatorBond.Validate(); err != nil {
+		s.clusterInfo = nil
+		return nil, err
+	}
+	if err := s.setDepositOffers(req.GetDepositOffers()); err != nil {
+		s.clusterInfo = nil
+		return nil, err
+	}
+	keysCfg := network.Config{FundingKeys: req.FundingKeys, FundingKeyFile: req.GetFundingKeyFile(), AdminKey: req.GetAdminKey()}
+	if _, err := keysCfg.GetFundingKeys(); err != nil {
+		s.clusterInfo = nil
+		return nil, err
+	}
+	if _, err := keysCfg.GetAdminKey(); err != nil {
+		s.clusterInfo = nil
+		return nil, err
+	}
+	if err := s.setUpgrades(req.CChainPhases); err != nil {
+		s.clusterInfo = nil
+		return nil, err
+	}
+
+	chainIDs, err := s.createBlockchains(req.BlockchainSpecs)
+	if err != nil {
+		s.clusterInfo = nil
+		return nil, err
+	}
+	s.clusterInfo.Healthy = true
+	s.clusterInfo.CustomChainsHealthy = true
+	return &rpcpb.StartResponse{ClusterInfo: s.copyClusterInfo(), ChainIds: chainIDs}, nil
+}
+
+func (s *Server) JoinExternalNetwork(_ context.Context, req *rpcpb.JoinExternalNetworkRequest) (*rpcpb.JoinExternalNetworkResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("JoinExternalNetwork", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo != nil {
+		return nil, server.ErrAlreadyBootstrapped
+	}
+	numNodes := server.MinNodes
+	if req.NumNodes != nil {
+		numNodes = req.GetNumNodes()
+	}
+	if numNodes < server.MinNodes {
+		return nil, server.ErrNotEnoughNodesForStart
+	}
+	execPath, pluginDir := s.resolveBinary(req.GetExecPath(), req.GetPluginDir())
+	if err := utils.CheckExecPath(execPath); errors.Is(err, utils.ErrInvalidExecPath) {
+		return nil, err
+	}
+	genesisNetworkID, err := utils.NetworkIDFromGenesis([]byte(req.GetGenesis()))
+	if err != nil {
+		return nil, fmt.Errorf("invalid external network genesis: %w", err)
+	}
+	if genesisNetworkID != req.GetNetworkId() {
+		return nil, fmt.Errorf("network ID %d doesn't match the network ID %d of the genesis", req.GetNetworkId(), genesisNetworkID)
+	}
+	if len(req.GetBootstrapPeers()) == 0 {
+		return nil, server.ErrNoBootstrapPeers
+	}
+	externalPeers := make([]*rpcpb.BootstrapPeer, 0, len(req.GetBootstrapPeers()))
+	for _, peer := range req.GetBootstrapPeers() {
+		externalPeer := node.BootstrapPeer{ID: peer.Id, IP: peer.Ip}
+		if _, _, err := externalPeer.Parse(); err != nil {
+			return nil, err
+		}
+		externalPeers = append(externalPeers, &rpcpb.BootstrapPeer{Id: peer.Id, Ip: peer.Ip})
+	}
+	rootDataDir := req.GetRootDataDir()
+	if rootDataDir == "" {
+		rootDataDir = DefaultRootDataDir
+	}
+
+	s.clusterInfo = &rpcpb.ClusterInfo{
+		Pid:           int32(os.Getpid()),
+		RootDataDir:   rootDataDir,
+		NodeInfos:     map[string]*rpcpb.NodeInfo{},
+		ExternalPeers: externalPeers,
+	}
+	for i := uint32(1); i <= numNodes; i++ {
+		nodeName := fmt.Sprintf("node%d", i)
+		nodeInfo := s.newNodeInfo(nodeName, execPath, pluginDir, req.GetWhitelistedSubnets(), "")
+		for _, peer := range externalPeers {
+			nodeInfo.BootstrapPeers = append(nodeInfo.BootstrapPeers, proto.Clone(peer).(*rpcpb.BootstrapPeer))
+		}
+		s.clusterInfo.NodeInfos[nodeName] = nodeInfo
+	}
+	s.updateNodeNames()
+	s.addressStates = map[string]uint64{}
+	s.cChainRoles = map[common.Address]uint64{}
+	s.cChainDeployers = map[common.Address]bool{}
+	s.clusterInfo.Healthy = true
+	s.clusterInfo.CustomChainsHealthy = true
+	return &rpcpb.JoinExternalNetworkResponse{ClusterInfo: s.copyClusterInfo()}, nil
+}
+
+func (s *Server) CreateBlockchains(_ context.Context, req *rpcpb.CreateBlockchainsRequest) (*rpcpb.CreateBlockchainsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("CreateBlockchains", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	if len(req.BlockchainSpecs) == 0 {
+		return nil, server.ErrNoBlockchainSpec
+	}
+	chainIDs, err := s.createBlockchains(req.BlockchainSpecs)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.CreateBlockchainsResponse{ClusterInfo: s.copyClusterInfo(), ChainIds: chainIDs}, nil
+}
+
+func (s *Server) CreateSubnets(_ context.Context, req *rpcpb.CreateSubnetsRequest) (*rpcpb.CreateSubnetsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("CreateSubnets", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	subnetIDs := []string{}
+	for _, spec := range req.SubnetSpecs {
+		subnetID, err := s.createSubnet(spec)
+		if err != nil {
+			return nil, err
+		}
+		subnetIDs = append(subnetIDs, subnetID)
+	}
+	return &rpcpb.CreateSubnetsResponse{ClusterInfo: s.copyClusterInfo(), SubnetIds: subnetIDs}, nil
+}
+
+func (s *Server) Health(_ context.Context, req *rpcpb.HealthRequest) (*rpcpb.HealthResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("Health", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	return &rpcpb.HealthResponse{ClusterInfo: s.copyClusterInfo()}, nil
+}
+
+func (s *Server) URIs(_ context.Context, req *rpcpb.URIsRequest) (*rpcpb.URIsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("URIs", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	uris := []string{}
+	for _, nodeName := range s.clusterInfo.NodeNames {
+		uris = append(uris, s.clusterInfo.NodeInfos[nodeName].Uri)
+	}
+	return &rpcpb.URIsResponse{Uris: uris}, nil
+}
+
+func (s *Server) WaitForHealthy(_ context.Context, req *rpcpb.WaitForHealthyRequest) (*rpcpb.WaitForHealthyResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("WaitForHealthy", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	return &rpcpb.WaitForHealthyResponse{ClusterInfo: s.copyClusterInfo()}, nil
+}
+
+func (s *Server) Status(_ context.Context, req *rpcpb.StatusRequest) (*rpcpb.StatusResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("Status", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	return &rpcpb.StatusResponse{ClusterInfo: s.copyClusterInfo()}, nil
+}
+
+// Pushes the cluster info every [req.PushInterval] until the client cancels.
+func (s *Server) StreamStatus(req *rpcpb.StreamStatusRequest, stream rpcpb.ControlService_StreamStatusServer) error {
+	s.mu.Lock()
+	err := s.call("StreamStatus", req)
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	tc := time.NewTicker(1)
+	defer tc.Stop()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return server.ErrStatusCanceled
+		case <-tc.C:
+			tc.Reset(time.Duration(req.PushInterval))
+		}
+		s.mu.Lock()
+		clusterInfo := s.copyClusterInfo()
+		s.mu.Unlock()
+		if err := stream.Send(&rpcpb.StreamStatusResponse{ClusterInfo: clusterInfo}); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) RemoveNode(_ context.Context, req *rpcpb.RemoveNodeRequest) (*rpcpb.RemoveNodeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("RemoveNode", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	if _, err := s.getNode(req.Name); err != nil {
+		return nil, err
+	}
+	delete(s.clusterInfo.NodeInfos, req.Name)
+	delete(s.clusterInfo.AttachedPeerInfos, req.Name)
+	for _, participants := range s.clusterInfo.SubnetParticipants {
+		nodeNames := []string{}
+		for _, nodeName := range participants.NodeNames {
+			if nodeName != req.Name {
+				nodeNames = append(nodeNames, nodeName)
+			}
+		}
+		participants.NodeNames = nodeNames
+	}
+	s.updateNodeNames()
+	return &rpcpb.RemoveNodeResponse{ClusterInfo: s.copyClusterInfo()}, nil
+}
+
+func (s *Server) AddNode(_ context.Context, req *rpcpb.AddNodeRequest) (*rpcpb.AddNodeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("AddNode", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	if _, ok := s.clusterInfo.NodeInfos[req.Name]; ok {
+		return nil, fmt.Errorf("%w: %q", ErrNodeExists, req.Name)
+	}
+	if _, err := server.GetValidatorConfig(req.GetValidatorSpec()); err != nil {
+		return nil, err
+	}
+	role := node.Role(req.Role)
+	if err := role.Validate(); err != nil {
+		return nil, err
+	}
+	bootstrapPeers, err := s.getBootstrapPeers(req.BootstrapNodes, req.BootstrapPeers)
+	if err != nil {
+		return nil, err
+	}
+	execPath, pluginDir := s.resolveBinary(req.ExecPath, req.PluginDir)
+	nodeInfo := s.newNodeInfo(
+		req.Name,
+		execPath,
+		pluginDir,
+		"",
+		req.GetNodeConfig(),
+	)
+	nodeInfo.Role = role.String()
+	nodeInfo.BootstrapPeers = bootstrapPeers
+	s.clusterInfo.NodeInfos[req.Name] = nodeInfo
+	s.updateNodeNames()
+	return &rpcpb.AddNodeResponse{ClusterInfo: s.copyClusterInfo()}, nil
+}
+
+// Returns the peers of the running nodes named [nodeNames] followed by
+// [peers], as the real server reports them. Bootstrapping from the beacons
+// isn't reported.
+// Assumes [s.mu] is held and there is a network.
+func (s *Server) getBootstrapPeers(nodeNames []string, peers []*rpcpb.BootstrapPeer) ([]*rpcpb.BootstrapPeer, error) {
+	var bootstrapPeers []*rpcpb.BootstrapPeer
+	for _, nodeName := range nodeNames {
+		nodeInfo, ok := s.clusterInfo.NodeInfos[nodeName]
+		if !ok || nodeInfo.Paused {
+			return nil, fmt.Errorf("bootstrap node %q isn't running", nodeName)
+		}
+		uri, err := url.Parse(nodeInfo.Uri)
+		if err != nil {
+			return nil, err
+		}
+		apiPort, err := strconv.Atoi(uri.Port())
+		if err != nil {
+			return nil, err
+		}
+		bootstrapPeers = append(bootstrapPeers, &rpcpb.BootstrapPeer{
+			Name: nodeName,
+			Id:   nodeInfo.Id,
+			Ip:   fmt.Sprintf("%s:%d", uri.Hostname(), apiPort+1),
+		})
+	}
+	for _, peer := range peers {
+		bootstrapPeer := node.BootstrapPeer{ID: peer.Id, IP: peer.Ip}
+		if _, _, err := bootstrapPeer.Parse(); err != nil {
+			return nil, err
+		}
+		bootstrapPeers = append(bootstrapPeers, &rpcpb.BootstrapPeer{Id: peer.Id, Ip: peer.Ip})
+	}
+	return bootstrapPeers, nil
+}
+
+func (s *Server) RestartNode(_ context.Context, req *rpcpb.RestartNodeRequest) (*rpcpb.RestartNodeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("RestartNode", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	nodeInfo, err := s.getNode(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	execPath, pluginDir := s.resolveBinary(req.GetExecPath(), req.PluginDir)
+	if req.ExecPath != nil {
+		nodeInfo.ExecPath = execPath
+	}
+	if req.WhitelistedSubnets != nil {
+		nodeInfo.WhitelistedSubnets = req.GetWhitelistedSubnets()
+	}
+	if pluginDir != "" {
+		nodeInfo.PluginDir = pluginDir
+	}
+	nodeInfo.Paused = false
+	return &rpcpb.RestartNodeResponse{ClusterInfo: s.copyClusterInfo()}, nil
+}
+
+func (s *Server) PauseNode(_ context.Context, req *rpcpb.PauseNodeRequest) (*rpcpb.PauseNodeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("PauseNode", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	nodeInfo, err := s.getNode(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	nodeInfo.Paused = true
+	return &rpcpb.PauseNodeResponse{ClusterInfo: s.copyClusterInfo()}, nil
+}
+
+func (s *Server) ResumeNode(_ context.Context, req *rpcpb.ResumeNodeRequest) (*rpcpb.ResumeNodeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("ResumeNode", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	nodeInfo, err := s.getNode(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	nodeInfo.Paused = false
+	return &rpcpb.ResumeNodeResponse{ClusterInfo: s.copyClusterInfo()}, nil
+}
+
+// Returns the last cluster info, marked unhealthy, as the real server does.
+// Assumes [s.mu] is held.
+func (s *Server) stop() *rpcpb.ClusterInfo {
+	if s.clusterInfo != nil {
+		s.lastClusterInfo = s.clusterInfo
+		s.clusterInfo = nil
+	}
+	if s.lastClusterInfo == nil {
+		return nil
+	}
+	s.lastClusterInfo.Healthy = false
+	s.lastClusterInfo.CustomChainsHealthy = false
+	return proto.Clone(s.lastClusterInfo).(*rpcpb.ClusterInfo)
+}
+
+func (s *Server) Stop(_ context.Context, req *rpcpb.StopRequest) (*rpcpb.StopResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("Stop", req); err != nil {
+		return nil, err
+	}
+	return &rpcpb.StopResponse{ClusterInfo: s.stop()}, nil
+}
+
+func (s *Server) AttachPeer(_ context.Context, req *rpcpb.AttachPeerRequest) (*rpcpb.AttachPeerResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("AttachPeer", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	if _, err := s.getNode(req.NodeName); err != nil {
+		return nil, err
+	}
+	peerInfo := &rpcpb.AttachedPeerInfo{Id: s.newNodeID().String()}
+	if s.clusterInfo.AttachedPeerInfos == nil {
+		s.clusterInfo.AttachedPeerInfos = map[string]*rpcpb.ListOfAttachedPeerInfo{}
+	}
+	peers, ok := s.clusterInfo.AttachedPeerInfos[req.NodeName]
+	if !ok {
+		peers = &rpcpb.ListOfAttachedPeerInfo{}
+		s.clusterInfo.AttachedPeerInfos[req.NodeName] = peers
+	}
+	peers.Peers = append(peers.Peers, peerInfo)
+	return &rpcpb.AttachPeerResponse{
+		ClusterInfo:      s.copyClusterInfo(),
+		AttachedPeerInfo: proto.Clone(peerInfo).(*rpcpb.AttachedPeerInfo),
+	}, nil
+}
+
+func (s *Server) SendOutboundMessage(_ context.Context, req *rpcpb.SendOutboundMessageRequest) (*rpcpb.SendOutboundMessageResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("SendOutboundMessage", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	for _, peerInfo := range s.clusterInfo.AttachedPeerInfos[req.NodeName].GetPeers() {
+		if peerInfo.Id == req.PeerId {
+			return &rpcpb.SendOutboundMessageResponse{Sent: true}, nil
+		}
+	}
+	return nil, server.ErrPeerNotFound
+}
+
+// Saves the cluster info and stops the network, as the real server does.
+func (s *Server) SaveSnapshot(_ context.Context, req *rpcpb.SaveSnapshotRequest) (*rpcpb.SaveSnapshotResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("SaveSnapshot", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	if req.SnapshotName == "" {
+		return nil, fmt.Errorf("invalid snapshotName %q", req.SnapshotName)
+	}
+	if _, ok := s.snapshots[req.SnapshotName]; ok {
+		return nil, fmt.Errorf("%w: %q", ErrSnapshotExists, req.SnapshotName)
+	}
+	s.snapshots[req.SnapshotName] = s.copyClusterInfo()
+	s.stop()
+	return &rpcpb.SaveSnapshotResponse{
+		SnapshotPath: filepath.Join(SnapshotsDir, snapshotPrefix+req.SnapshotName),
+	}, nil
+}
+
+func (s *Server) LoadSnapshot(_ context.Context, req *rpcpb.LoadSnapshotRequest) (*rpcpb.LoadSnapshotResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("LoadSnapshot", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo != nil {
+		return nil, server.ErrAlreadyBootstrapped
+	}
+	snapshot, ok := s.snapshots[req.SnapshotName]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrSnapshotNotFound, req.SnapshotName)
+	}
+	s.clusterInfo = proto.Clone(snapshot).(*rpcpb.ClusterInfo)
+	if req.RootDataDir != nil {
+		s.clusterInfo.RootDataDir = req.GetRootDataDir()
+	}
+	execPath, pluginDir := s.resolveBinary(req.GetExecPath(), req.PluginDir)
+	for _, nodeInfo := range s.clusterInfo.NodeInfos {
+		if req.ExecPath != nil {
+			nodeInfo.ExecPath = execPath
+		}
+		if pluginDir != "" {
+			nodeInfo.PluginDir = pluginDir
+		}
+	}
+	s.clusterInfo.Healthy = true
+	s.clusterInfo.CustomChainsHealthy = true
+	return &rpcpb.LoadSnapshotResponse{ClusterInfo: s.copyClusterInfo()}, nil
+}
+
+func (s *Server) RemoveSnapshot(_ context.Context, req *rpcpb.RemoveSnapshotRequest) (*rpcpb.RemoveSnapshotResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("RemoveSnapshot", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	if _, ok := s.snapshots[req.SnapshotName]; !ok {
+		return nil, fmt.Errorf("%w: %q", ErrSnapshotNotFound, req.SnapshotName)
+	}
+	delete(s.snapshots, req.SnapshotName)
+	return &rpcpb.RemoveSnapshotResponse{}, nil
+}
+
+func (s *Server) GetSnapshotNames(_ context.Context, req *rpcpb.GetSnapshotNamesRequest) (*rpcpb.GetSnapshotNamesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("GetSnapshotNames", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	snapshotNames := maps.Keys(s.snapshots)
+	sort.Strings(snapshotNames)
+	return &rpcpb.GetSnapshotNamesResponse{SnapshotNames: snapshotNames}, nil
+}
+
+func (s *Server) MigrateSnapshot(_ context.Context, req *rpcpb.MigrateSnapshotRequest) (*rpcpb.MigrateSnapshotResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("MigrateSnapshot", req); err != nil {
+		return nil, err
+	}
+	if _, ok := s.snapshots[req.SnapshotName]; !ok {
+		return nil, fmt.Errorf("%w: %q", ErrSnapshotNotFound, req.SnapshotName)
+	}
+	// snapshots of the fake are always up to date
+	return &rpcpb.MigrateSnapshotResponse{
+		FromVersion: local.SnapshotFormatVersion,
+		ToVersion:   local.SnapshotFormatVersion,
+	}, nil
+}
+
+func (s *Server) RegisterBinary(_ context.Context, req *rpcpb.RegisterBinaryRequest) (*rpcpb.RegisterBinaryResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("RegisterBinary", req); err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, fmt.Errorf("%w %q", binaries.ErrInvalidName, req.Name)
+	}
+	if _, ok := s.binaries[req.Name]; ok {
+		return nil, fmt.Errorf("%w: %q", binaries.ErrBinaryExists, req.Name)
+	}
+	// binaries are not read, the hash is derived from the path
+	hash := sha256.Sum256([]byte(req.ExecPath))
+	binary := &rpcpb.BinaryInfo{
+		Name:     req.Name,
+		ExecPath: filepath.Join(BinariesDir, req.Name, "camino-node"),
+		Hash:     hex.EncodeToString(hash[:]),
+		Version:  "camino-node/" + req.Name,
+	}
+	if req.PluginDir != "" {
+		binary.PluginDir = filepath.Join(BinariesDir, req.Name, "plugins")
+	}
+	s.binaries[req.Name] = binary
+	return &rpcpb.RegisterBinaryResponse{Binary: proto.Clone(binary).(*rpcpb.BinaryInfo)}, nil
+}
+
+func (s *Server) ListBinaries(_ context.Context, req *rpcpb.ListBinariesRequest) (*rpcpb.ListBinariesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("ListBinaries", req); err != nil {
+		return nil, err
+	}
+	names := maps.Keys(s.binaries)
+	sort.Strings(names)
+	binaryInfos := make([]*rpcpb.BinaryInfo, 0, len(names))
+	for _, name := range names {
+		binaryInfos = append(binaryInfos, proto.Clone(s.binaries[name]).(*rpcpb.BinaryInfo))
+	}
+	return &rpcpb.ListBinariesResponse{Binaries: binaryInfos}, nil
+}
+
+func (s *Server) RemoveBinary(_ context.Context, req *rpcpb.RemoveBinaryRequest) (*rpcpb.RemoveBinaryResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("RemoveBinary", req); err != nil {
+		return nil, err
+	}
+	if _, ok := s.binaries[req.Name]; !ok {
+		return nil, fmt.Errorf("%w: %q", binaries.ErrBinaryMissing, req.Name)
+	}
+	delete(s.binaries, req.Name)
+	return &rpcpb.RemoveBinaryResponse{}, nil
+}
+
+func (s *Server) UploadArtifact(stream rpcpb.ControlService_UploadArtifactServer) error {
+	h := sha256.New()
+	size := 0
+	for first := true; ; first = false {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if first {
+			s.mu.Lock()
+			err := s.call("UploadArtifact", req)
+			s.mu.Unlock()
+			if err != nil {
+				return err
+			}
+		}
+		_, _ = h.Write(req.Chunk)
+		size += len(req.Chunk)
+	}
+	// contents are not stored, references are not checked by the fake
+	id := hex.EncodeToString(h.Sum(nil))
+	return stream.SendAndClose(&rpcpb.UploadArtifactResponse{
+		ArtifactId: id,
+		Ref:        artifacts.Ref(id),
+		Size:       uint64(size),
+	})
+}
+
+func (s *Server) ReplaceVMPlugin(_ context.Context, req *rpcpb.ReplaceVMPluginRequest) (*rpcpb.ReplaceVMPluginResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("ReplaceVMPlugin", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	vmID, err := utils.VMID(req.VmName)
+	if err != nil {
+		return nil, server.ErrInvalidVMName
+	}
+	restarted := map[string]struct{}{}
+	for _, chainInfo := range s.clusterInfo.CustomChains {
+		if chainInfo.VmId != vmID.String() {
+			continue
+		}
+		for _, nodeName := range s.clusterInfo.SubnetParticipants[chainInfo.SubnetId].GetNodeNames() {
+			if !s.clusterInfo.NodeInfos[nodeName].GetPaused() {
+				restarted[nodeName] = struct{}{}
+			}
+		}
+	}
+	if len(restarted) == 0 {
+		return nil, fmt.Errorf("%w %s", local.ErrVMNotInUse, vmID)
+	}
+	restartedNodes := maps.Keys(restarted)
+	sort.Strings(restartedNodes)
+	return &rpcpb.ReplaceVMPluginResponse{ClusterInfo: s.copyClusterInfo(), RestartedNodes: restartedNodes}, nil
+}
+
+func (s *Server) UpdateChainConfig(_ context.Context, req *rpcpb.UpdateChainConfigRequest) (*rpcpb.UpdateChainConfigResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("UpdateChainConfig", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	if req.ChainConfig == "" && len(req.PerNodeChainConfig) == 0 {
+		return nil, local.ErrEmptyConfig
+	}
+	for nodeName := range req.PerNodeChainConfig {
+		if _, err := s.getNode(nodeName); err != nil {
+			return nil, err
+		}
+	}
+	chainInfo, ok := s.clusterInfo.CustomChains[req.ChainId]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", local.ErrChainNotFound, req.ChainId)
+	}
+	restarted := []string{}
+	for _, nodeName := range s.clusterInfo.SubnetParticipants[chainInfo.SubnetId].GetNodeNames() {
+		chainConfig := req.ChainConfig
+		if cfg, ok := req.PerNodeChainConfig[nodeName]; ok {
+			chainConfig = cfg
+		}
+		nodeInfo := s.clusterInfo.NodeInfos[nodeName]
+		if setConfig(&nodeInfo.ChainConfigs, req.ChainId, chainConfig) && !nodeInfo.Paused {
+			restarted = append(restarted, nodeName)
+		}
+	}
+	sort.Strings(restarted)
+	return &rpcpb.UpdateChainConfigResponse{ClusterInfo: s.copyClusterInfo(), RestartedNodes: restarted}, nil
+}
+
+func (s *Server) UpdateSubnetConfig(_ context.Context, req *rpcpb.UpdateSubnetConfigRequest) (*rpcpb.UpdateSubnetConfigResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("UpdateSubnetConfig", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	if req.SubnetConfig == "" {
+		return nil, local.ErrEmptyConfig
+	}
+	participants, ok := s.clusterInfo.SubnetParticipants[req.SubnetId]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrSubnetNotFound, req.SubnetId)
+	}
+	restarted := []string{}
+	for _, nodeName := range participants.NodeNames {
+		nodeInfo := s.clusterInfo.NodeInfos[nodeName]
+		if setConfig(&nodeInfo.SubnetConfigs, req.SubnetId, req.SubnetConfig) && !nodeInfo.Paused {
+			restarted = append(restarted, nodeName)
+		}
+	}
+	sort.Strings(restarted)
+	return &rpcpb.UpdateSubnetConfigResponse{ClusterInfo: s.copyClusterInfo(), RestartedNodes: restarted}, nil
+}
+
+// The address states of the genesis are not reported.
+func (s *Server) SetAddressState(_ context.Context, req *rpcpb.SetAddressStateRequest) (*rpcpb.SetAddressStateResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("SetAddressState", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	state, err := network.ParseAddressState(req.State)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, _, err := address.Parse(req.Address); err != nil {
+		return nil, fmt.Errorf("invalid address %q: %w", req.Address, err)
+	}
+	if req.Remove {
+		s.addressStates[req.Address] &^= uint64(1) << state
+	} else {
+		s.addressStates[req.Address] |= uint64(1) << state
+	}
+	states := s.addressStates[req.Address]
+	return &rpcpb.SetAddressStateResponse{
+		ClusterInfo: s.copyClusterInfo(),
+		TxId:        s.newID().String(),
+		States:      states,
+		StateNames:  network.AddressStateNames(states),
+	}, nil
+}
+
+func (s *Server) GetAddressState(_ context.Context, req *rpcpb.GetAddressStateRequest) (*rpcpb.GetAddressStateResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("GetAddressState", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	if _, _, _, err := address.Parse(req.Address); err != nil {
+		return nil, fmt.Errorf("invalid address %q: %w", req.Address, err)
+	}
+	states := s.addressStates[req.Address]
+	return &rpcpb.GetAddressStateResponse{
+		ClusterInfo: s.copyClusterInfo(),
+		States:      states,
+		StateNames:  network.AddressStateNames(states),
+	}, nil
+}
+
+func (s *Server) GetDepositOffers(_ context.Context, req *rpcpb.GetDepositOffersRequest) (*rpcpb.GetDepositOffersResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("GetDepositOffers", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	now := uint64(time.Now().Unix())
+	offers := []*deposit.Offer{}
+	for _, offer := range s.depositOffers {
+		if offer.Start <= now && now <= offer.End {
+			offers = append(offers, offer)
+		}
+	}
+	return &rpcpb.GetDepositOffersResponse{
+		ClusterInfo:   s.copyClusterInfo(),
+		DepositOffers: server.ToRPCDepositOffers(offers),
+	}, nil
+}
+
+func (s *Server) Deposit(_ context.Context, req *rpcpb.DepositRequest) (*rpcpb.DepositResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("Deposit", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	offerID, err := ids.FromString(req.DepositOfferId)
+	if err != nil {
+		return nil, err
+	}
+	offer, err := s.getDepositOffer(offerID)
+	if err != nil {
+		return nil, err
+	}
+	now := uint64(time.Now().Unix())
+	switch {
+	case offer.Start > now || offer.End < now:
+		return nil, fmt.Errorf("deposit offer %s is not active", offerID)
+	case req.Amount < offer.MinAmount:
+		return nil, fmt.Errorf("deposit amount %d is less than the offer minimum %d", req.Amount, offer.MinAmount)
+	case req.Duration < offer.MinDuration || req.Duration > offer.MaxDuration:
+		return nil, fmt.Errorf("deposit duration %d is out of the offer range [%d, %d]", req.Duration, offer.MinDuration, offer.MaxDuration)
+	}
+	offer.DepositedAmount += req.Amount
+	txID := s.newID()
+	s.deposits[txID] = &deposit.Deposit{
+		DepositOfferID: offerID,
+		Start:          now,
+		Duration:       req.Duration,
+		Amount:         req.Amount,
+	}
+	return &rpcpb.DepositResponse{
+		ClusterInfo: s.copyClusterInfo(),
+		TxId:        txID.String(),
+	}, nil
+}
+
+// The fake has no validator or expired deposit rewards, only the rewards of
+// the given deposits are claimed.
+func (s *Server) ClaimRewards(_ context.Context, req *rpcpb.ClaimRewardsRequest) (*rpcpb.ClaimRewardsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("ClaimRewards", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	depositTxIDs, err := server.ParseIDs(req.DepositTxIds)
+	if err != nil {
+		return nil, err
+	}
+	deposits, offers, err := s.getDeposits(depositTxIDs)
+	if err != nil {
+		return nil, err
+	}
+	now := uint64(time.Now().Unix())
+	claimed := uint64(0)
+	for i, d := range deposits {
+		reward := d.ClaimableReward(offers[i], now)
+		d.ClaimedRewardAmount += reward
+		claimed += reward
+	}
+	if claimed == 0 {
+		return nil, network.ErrNothingToClaim
+	}
+	return &rpcpb.ClaimRewardsResponse{
+		ClusterInfo: s.copyClusterInfo(),
+		TxId:        s.newID().String(),
+		Amount:      claimed,
+	}, nil
+}
+
+func (s *Server) UnlockDeposit(_ context.Context, req *rpcpb.UnlockDepositRequest) (*rpcpb.UnlockDepositResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("UnlockDeposit", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	depositTxIDs, err := server.ParseIDs(req.DepositTxIds)
+	if err != nil {
+		return nil, err
+	}
+	deposits, offers, err := s.getDeposits(depositTxIDs)
+	if err != nil {
+		return nil, err
+	}
+	now := uint64(time.Now().Unix())
+	unlocked := uint64(0)
+	for i, d := range deposits {
+		amount := d.UnlockableAmount(offers[i], now)
+		d.UnlockedAmount += amount
+		unlocked += amount
+	}
+	if unlocked == 0 {
+		return nil, network.ErrNothingToUnlock
+	}
+	return &rpcpb.UnlockDepositResponse{
+		ClusterInfo: s.copyClusterInfo(),
+		TxId:        s.newID().String(),
+		Amount:      unlocked,
+	}, nil
+}
+
+func (s *Server) CreateMultisigAlias(_ context.Context, req *rpcpb.CreateMultisigAliasRequest) (*rpcpb.CreateMultisigAliasResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("CreateMultisigAlias", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	if _, err := network.ParseMultisigAlias(req.Threshold, req.Addresses, req.Memo); err != nil {
+		return nil, err
+	}
+	// the alias has the chain and hrp of its owners
+	chainID, hrp, _, err := address.Parse(req.Addresses[0])
+	if err != nil {
+		return nil, err
+	}
+	txID := s.newID()
+	alias, err := address.Format(chainID, hrp, multisig.ComputeAliasID(txID).Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.CreateMultisigAliasResponse{
+		ClusterInfo: s.copyClusterInfo(),
+		TxId:        txID.String(),
+		Alias:       alias,
+	}, nil
+}
+
+func (s *Server) GrantCChainRole(_ context.Context, req *rpcpb.CChainRoleRequest) (*rpcpb.CChainRoleResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("GrantCChainRole", req); err != nil {
+		return nil, err
+	}
+	return s.setCChainRole(req, false)
+}
+
+func (s *Server) RevokeCChainRole(_ context.Context, req *rpcpb.CChainRoleRequest) (*rpcpb.CChainRoleResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("RevokeCChainRole", req); err != nil {
+		return nil, err
+	}
+	return s.setCChainRole(req, true)
+}
+
+// Assumes [s.mu] is held.
+func (s *Server) setCChainRole(req *rpcpb.CChainRoleRequest, revoke bool) (*rpcpb.CChainRoleResponse, error) {
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	role, err := network.ParseCChainRole(req.Role)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := parseEthAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case req.Role == network.CChainDeployerRole:
+		s.cChainDeployers[addr] = !revoke
+	case revoke:
+		s.cChainRoles[addr] &^= role
+	default:
+		s.cChainRoles[addr] |= role
+	}
+	roles := s.cChainRoles[addr]
+	return &rpcpb.CChainRoleResponse{
+		ClusterInfo: s.copyClusterInfo(),
+		TxHash:      common.Hash(s.newID()).Hex(),
+		Roles:       roles,
+		RoleNames:   network.CChainRoleNames(roles, s.cChainDeployers[addr]),
+	}, nil
+}
+
+func (s *Server) GetCChainRoles(_ context.Context, req *rpcpb.GetCChainRolesRequest) (*rpcpb.GetCChainRolesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("GetCChainRoles", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	addr, err := parseEthAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	roles := s.cChainRoles[addr]
+	return &rpcpb.GetCChainRolesResponse{
+		ClusterInfo: s.copyClusterInfo(),
+		Roles:       roles,
+		RoleNames:   network.CChainRoleNames(roles, s.cChainDeployers[addr]),
+	}, nil
+}
+
+// Delegations are listed in the node info right away, and never expire.
+// The fake has no funding address, so the rewards owners are only listed
+// if given.
+func (s *Server) AddDelegator(_ context.Context, req *rpcpb.AddDelegatorRequest) (*rpcpb.AddDelegatorResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.call("AddDelegator", req); err != nil {
+		return nil, err
+	}
+	if s.clusterInfo == nil {
+		return nil, server.ErrNotBootstrapped
+	}
+	spec, err := server.GetDelegatorSpec(req)
+	if err != nil {
+		return nil, err
+	}
+	nodeInfo, err := s.getNode(req.NodeName)
+	if err != nil {
+		return nil, err
+	}
+	if nodeInfo.Role != node.ValidatorRole.String() {
+		return nil, fmt.Errorf("%w: %q", local.ErrNotValidator, req.NodeName)
+	}
+	subnetID := ""
+	if spec.SubnetID != ids.Empty {
+		subnetID = spec.SubnetID.String()
+		participants, ok := s.clusterInfo.SubnetParticipants[subnetID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrSubnetNotFound, subnetID)
+		}
+		if !slices.Contains(participants.NodeNames, req.NodeName) {
+			return nil, fmt.Errorf("%w: %q of subnet %s", local.ErrNotValidator, req.NodeName, subnetID)
+		}
+	}
+	duration := spec.Duration
+	if duration == 0 {
+		duration = defaultDelegationDuration
+	}
+	rewardsOwners := []string{}
+	if spec.RewardsOwner != "" {
+		if _, _, _, err := address.Parse(spec.RewardsOwner); err != nil {
+			return nil, fmt.Errorf("invalid address %q: %w", spec.RewardsOwner, err)
+		}
+		rewardsOwners = append(rewardsOwners, spec.RewardsOwner)
+	}
+	amount := spec.Amount
+	if amount == 0 {
+		amount = minDelegatorStake
+	}
+	txID := s.newID()
+	now := time.Now()
+	nodeInfo.Delegators = append(nodeInfo.Delegators, &rpcpb.DelegatorInfo{
+		TxId:          txID.String(),
+		SubnetId:      subnetID,
+		Amount:        amount,
+		StartTime:     uint64(now.Unix()),
+		EndTime:       uint64(now.Add(duration).Unix()),
+		RewardsOwners: rewardsOwners,
+	})
+	return &rpcpb.AddDelegatorResponse{
+		ClusterInfo: s.copyClusterInfo(),
+		TxId:        txID.String(),
+	}, nil
+}
+
+func parseEthAddress(addr string) (common.Address, error) {
+	if !common.IsHexAddress(addr) {
+		return common.Address{}, fmt.Errorf("invalid C-chain address %q", addr)
+	}
+	return common.HexToAddress(addr), nil
+}
+
+// Sets the deposit offers of the default genesis plus the JSON list
+// [offersJSON], started now, and forgets previous deposits.
+// Assumes [s.mu] is held.
+func (s *Server) setDepositOffers(offersJSON string) error {
+	offers, err := network.ParseDepositOffers(offersJSON)
+	if err != nil {
+		return err
+	}
+	cfg := local.NewDefaultConfig("")
+	if err := cfg.AddDepositOffers(offers); err != nil {
+		return err
+	}
+	s.depositOffers, err = network.GetDepositOffers([]byte(cfg.Genesis), uint64(time.Now().Unix()))
+	if err != nil {
+		return err
+	}
+	s.deposits = map[ids.ID]*deposit.Deposit{}
+	return nil
+}
+
+// Assumes [s.mu] is held.
+func (s *Server) getDepositOffer(offerID ids.ID) (*deposit.Offer, error) {
+	for _, offer := range s.depositOffers {
+		if offer.ID == offerID {
+			return offer, nil
+		}
+	}
+	return nil, fmt.Errorf("deposit offer %s not found", offerID)
+}
+
+// Returns the deposits of [depositTxIDs] and their offers.
+// Assumes [s.mu] is held.
+func (s *Server) getDeposits(depositTxIDs []ids.ID) ([]*deposit.Deposit, []*deposit.Offer, error) {
+	deposits := make([]*deposit.Deposit, len(depositTxIDs))
+	offers := make([]*deposit.Offer, len(depositTxIDs))
+	for i, txID := range depositTxIDs {
+		d, ok := s.deposits[txID]
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: %s", ErrDepositNotFound, txID)
+		}
+		offer, err := s.getDepositOffer(d.DepositOfferID)
+		if err != nil {
+			return nil, nil, err
+		}
+		deposits[i] = d
+		offers[i] = offer
+	}
+	return deposits, offers, nil
+}
+
+// Sets entry [key] of [configs] to [config], unless [config] is empty.
+// Returns true if the entry changed.
+func setConfig(configs *map[string]string, key string, config string) bool {
+	if config == "" {
+		return false
+	}
+	if current, ok := (*configs)[key]; ok && current == config {
+		return false
+	}
+	if *configs == nil {
+		*configs = map[string]string{}
+	}
+	(*configs)[key] = config
+	return true
+}
+
+// Reports the scheduled upgrades, with offsets from now.
+// The phases of the default genesis are not reported.
+// Assumes [s.mu] is held and there is a network.
+func (s *Server) setUpgrades(cChainPhases map[string]string) error {
+	schedule := network.UpgradeSchedule{CChainPhases: cChainPhases}
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+	now := time.Now()
+	for name, timeStr := range cChainPhases {
+		t, err := network.ParseUpgradeTime(timeStr, now)
+		if err != nil {
+			return err
+		}
+		s.clusterInfo.Upgrades = append(s.clusterInfo.Upgrades, &rpcpb.UpgradeInfo{
+			Chain:  network.CChainAlias,
+			Name:   name,
+			Time:   t.Unix(),
+			Active: !now.Before(t),
+		})
+	}
+	sort.Slice(s.clusterInfo.Upgrades, func(i, j int) bool {
+		a, b := s.clusterInfo.Upgrades[i], s.clusterInfo.Upgrades[j]
+		if a.Time != b.Time {
+			return a.Time < b.Time
+		}
+		return a.Name < b.Name
+	})
+	return nil
+}
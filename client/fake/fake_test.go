@@ -0,0 +1,558 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/client"
+	"github.com/ava-labs/avalanche-network-runner/local"
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"github.com/ava-labs/avalanche-network-runner/server"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+const execPath = "/fake/camino-node"
+
+func TestLifecycle(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s := NewServer()
+	cli := NewClient(s, logging.NoLog{})
+	defer cli.Close()
+	ctx := context.Background()
+
+	_, err := cli.Status(ctx)
+	require.True(server.IsServerError(err, server.ErrNotBootstrapped))
+
+	startResp, err := cli.Start(ctx, execPath,
+		client.WithNumNodes(3),
+		client.WithBlockchainSpecs([]*rpcpb.BlockchainSpec{{VmName: "subnetevm"}}),
+	)
+	require.NoError(err)
+	require.Equal([]string{"node1", "node2", "node3"}, startResp.ClusterInfo.NodeNames)
+	require.Len(startResp.ChainIds, 1)
+	chainInfo := startResp.ClusterInfo.CustomChains[startResp.ChainIds[0]]
+	require.Equal("subnetevm", chainInfo.ChainName)
+	require.Equal([]string{"node1", "node2", "node3"}, startResp.ClusterInfo.SubnetParticipants[chainInfo.SubnetId].NodeNames)
+
+	_, err = cli.Start(ctx, execPath)
+	require.True(server.IsServerError(err, server.ErrAlreadyBootstrapped))
+
+	addResp, err := cli.AddNode(ctx, "node4", execPath)
+	require.NoError(err)
+	require.Len(addResp.ClusterInfo.NodeNames, 4)
+	uris, err := cli.URIs(ctx)
+	require.NoError(err)
+	require.Len(uris, 4)
+
+	removeResp, err := cli.RemoveNode(ctx, "node1")
+	require.NoError(err)
+	require.Equal([]string{"node2", "node3", "node4"}, removeResp.ClusterInfo.NodeNames)
+	require.Equal([]string{"node2", "node3"}, removeResp.ClusterInfo.SubnetParticipants[chainInfo.SubnetId].NodeNames)
+	_, err = cli.RemoveNode(ctx, "node1")
+	require.True(server.IsServerError(err, server.ErrNodeNotFound))
+
+	pauseResp, err := cli.PauseNode(ctx, "node2")
+	require.NoError(err)
+	require.True(pauseResp.ClusterInfo.NodeInfos["node2"].Paused)
+
+	replaceResp, err := cli.ReplaceVMPlugin(ctx, "subnetevm", execPath)
+	require.NoError(err)
+	require.Equal([]string{"node3"}, replaceResp.RestartedNodes)
+	_, err = cli.ReplaceVMPlugin(ctx, "timestampvm", execPath)
+	require.ErrorContains(err, local.ErrVMNotInUse.Error())
+
+	chainConfigResp, err := cli.UpdateChainConfig(ctx, startResp.ChainIds[0], `{"log-level":"debug"}`, map[string]string{"node2": `{}`})
+	require.NoError(err)
+	require.Equal([]string{"node3"}, chainConfigResp.RestartedNodes)
+	require.Equal(`{}`, chainConfigResp.ClusterInfo.NodeInfos["node2"].ChainConfigs[startResp.ChainIds[0]])
+	// unchanged configs don't restart nodes
+	chainConfigResp, err = cli.UpdateChainConfig(ctx, startResp.ChainIds[0], `{"log-level":"debug"}`, nil)
+	require.NoError(err)
+	require.Empty(chainConfigResp.RestartedNodes)
+	subnetConfigResp, err := cli.UpdateSubnetConfig(ctx, chainInfo.SubnetId, `{"proposerMinBlockDelay":0}`)
+	require.NoError(err)
+	require.Equal([]string{"node3"}, subnetConfigResp.RestartedNodes)
+	_, err = cli.UpdateSubnetConfig(ctx, chainInfo.SubnetId, "")
+	require.True(server.IsServerError(err, local.ErrEmptyConfig))
+
+	attachResp, err := cli.AttachPeer(ctx, "node3")
+	require.NoError(err)
+	sendResp, err := cli.SendOutboundMessage(ctx, "node3", attachResp.AttachedPeerInfo.Id, 0, nil)
+	require.NoError(err)
+	require.True(sendResp.Sent)
+
+	_, err = cli.SaveSnapshot(ctx, "snap")
+	require.NoError(err)
+	require.Nil(s.ClusterInfo())
+
+	loadResp, err := cli.LoadSnapshot(ctx, "snap")
+	require.NoError(err)
+	require.Equal([]string{"node2", "node3", "node4"}, loadResp.ClusterInfo.NodeNames)
+	require.True(loadResp.ClusterInfo.Healthy)
+	names, err := cli.GetSnapshotNames(ctx)
+	require.NoError(err)
+	require.Equal([]string{"snap"}, names)
+
+	stopResp, err := cli.Stop(ctx)
+	require.NoError(err)
+	require.False(stopResp.ClusterInfo.Healthy)
+	require.Equal(1, s.Calls("Stop"))
+}
+
+func TestErrorInjection(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s := NewServer()
+	cli := NewClient(s, logging.NoLog{})
+	defer cli.Close()
+	ctx := context.Background()
+
+	errTest := errors.New("test error")
+	s.FailNext("Start", errTest, 1)
+	_, err := cli.Start(ctx, execPath)
+	require.True(server.IsServerError(err, errTest))
+	_, err = cli.Start(ctx, execPath)
+	require.NoError(err)
+
+	s.SetError("Health", errTest)
+	for i := 0; i < 2; i++ {
+		_, err = cli.Health(ctx)
+		require.True(server.IsServerError(err, errTest))
+	}
+	s.SetError("Health", nil)
+	_, err = cli.Health(ctx)
+	require.NoError(err)
+
+	reqs := s.Requests("Start")
+	require.Len(reqs, 2)
+	require.Equal(execPath, reqs[1].(*rpcpb.StartRequest).ExecPath)
+}
+
+func TestUpgradeSchedule(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	cli := NewClient(NewServer(), logging.NoLog{})
+	defer cli.Close()
+	ctx := context.Background()
+
+	_, err := cli.Start(ctx, execPath, client.WithUpgradeSchedule(
+		map[string]string{"homesteadBlock": "0s"},
+	))
+	require.ErrorContains(err, network.ErrUnknownPhase.Error())
+
+	startResp, err := cli.Start(ctx, execPath, client.WithUpgradeSchedule(
+		map[string]string{
+			"sunrisePhase0BlockTimestamp": "1h",
+			"banffBlockTimestamp":         "0s",
+		},
+	))
+	require.NoError(err)
+	upgrades := startResp.ClusterInfo.Upgrades
+	require.Len(upgrades, 2)
+	require.Equal("banffBlockTimestamp", upgrades[0].Name)
+	require.True(upgrades[0].Active)
+	require.Equal("sunrisePhase0BlockTimestamp", upgrades[1].Name)
+	require.False(upgrades[1].Active)
+}
+
+func TestAddressState(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	cli := NewClient(NewServer(), logging.NoLog{})
+	defer cli.Close()
+	ctx := context.Background()
+
+	const addr = "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68"
+	_, err := cli.SetAddressState(ctx, addr, "kycVerified", false)
+	require.True(server.IsServerError(err, server.ErrNotBootstrapped))
+
+	_, err = cli.Start(ctx, execPath)
+	require.NoError(err)
+	_, err = cli.SetAddressState(ctx, addr, "validator", false)
+	require.ErrorContains(err, network.ErrUnknownAddressState.Error())
+	_, err = cli.GetAddressState(ctx, "kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68")
+	require.ErrorContains(err, "invalid address")
+
+	setResp, err := cli.SetAddressState(ctx, addr, "kycVerified", false)
+	require.NoError(err)
+	require.NotEmpty(setResp.TxId)
+	_, err = cli.SetAddressState(ctx, addr, "consortiumMember", false)
+	require.NoError(err)
+	getResp, err := cli.GetAddressState(ctx, addr)
+	require.NoError(err)
+	require.Equal([]string{"kycVerified", "consortiumMember"}, getResp.StateNames)
+
+	setResp, err = cli.SetAddressState(ctx, addr, "kycVerified", true)
+	require.NoError(err)
+	require.Equal([]string{"consortiumMember"}, setResp.StateNames)
+}
+
+func TestValidatorBond(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s := NewServer()
+	cli := NewClient(s, logging.NoLog{})
+	defer cli.Close()
+	ctx := context.Background()
+
+	_, err := cli.Start(ctx, execPath, client.WithValidatorBond(0, "P-foo"))
+	require.ErrorContains(err, "invalid validator rewards owner")
+
+	const rewardsOwner = "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68"
+	_, err = cli.Start(ctx, execPath, client.WithValidatorBond(3_000_000_000_000, rewardsOwner))
+	require.NoError(err)
+	reqs := s.Requests("Start")
+	req, ok := reqs[len(reqs)-1].(*rpcpb.StartRequest)
+	require.True(ok)
+	require.Equal(uint64(3_000_000_000_000), req.GetValidatorBondAmount())
+	require.Equal(rewardsOwner, req.GetValidatorRewardsOwner())
+}
+
+func TestValidatorSpec(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s := NewServer()
+	cli := NewClient(s, logging.NoLog{})
+	defer cli.Close()
+	ctx := context.Background()
+
+	_, err := cli.Start(ctx, execPath)
+	require.NoError(err)
+
+	_, err = cli.AddNode(ctx, "node6", execPath, client.WithValidatorSpec(&rpcpb.ValidatorSpec{Duration: "1 year"}))
+	require.ErrorContains(err, "invalid validator duration")
+	_, err = cli.AddNode(ctx, "node6", execPath, client.WithValidatorSpec(&rpcpb.ValidatorSpec{DelegationFee: proto.Uint32(2_000_000)}))
+	require.Error(err)
+	validatorSpec := &rpcpb.ValidatorSpec{Stake: 3_000_000_000_000, DelegationFee: proto.Uint32(0), Duration: "720h"}
+	_, err = cli.AddNode(ctx, "node6", execPath, client.WithValidatorSpec(validatorSpec))
+	require.NoError(err)
+	reqs := s.Requests("AddNode")
+	req, ok := reqs[len(reqs)-1].(*rpcpb.AddNodeRequest)
+	require.True(ok)
+	require.Equal(validatorSpec.Duration, req.GetValidatorSpec().GetDuration())
+	// a zero fee is sent, not left to the default
+	require.NotNil(req.GetValidatorSpec().DelegationFee)
+
+	_, err = cli.CreateSubnets(ctx, []*rpcpb.SubnetSpec{{
+		Participants:       []string{"node1", "node6"},
+		ParticipantWeights: map[string]uint64{"node2": 2_000},
+	}})
+	require.ErrorContains(err, network.ErrInvalidWeight.Error())
+	_, err = cli.CreateSubnets(ctx, []*rpcpb.SubnetSpec{{
+		Participants:       []string{"node1", "node6"},
+		ParticipantWeights: map[string]uint64{"node6": 2_000},
+	}})
+	require.NoError(err)
+}
+
+func TestDeposits(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	cli := NewClient(NewServer(), logging.NoLog{})
+	defer cli.Close()
+	ctx := context.Background()
+
+	_, err := cli.Start(ctx, execPath, client.WithDepositOffers(`[{"minAmount": 0}]`))
+	require.ErrorContains(err, network.ErrInvalidDepositOffer.Error())
+	_, err = cli.Start(ctx, execPath, client.WithFundingKeys([]string{"PrivateKey-foo"}, ""))
+	require.ErrorContains(err, "invalid funding key 0")
+
+	_, err = cli.Start(ctx, execPath, client.WithDepositOffers(`[{
+		"interestRateNominator": 100000,
+		"endOffset": 3600,
+		"minAmount": 10,
+		"minDuration": 60,
+		"maxDuration": 600,
+		"memo": "fake offer"
+	}]`))
+	require.NoError(err)
+	offersResp, err := cli.GetDepositOffers(ctx)
+	require.NoError(err)
+	// default genesis offers and the added one
+	require.Len(offersResp.DepositOffers, 3)
+	offer := offersResp.DepositOffers[2]
+	require.Equal("fake offer", offer.Memo)
+
+	_, err = cli.Deposit(ctx, offer.Id, 1, 60)
+	require.ErrorContains(err, "less than the offer minimum")
+	_, err = cli.Deposit(ctx, offer.Id, 10, 6000)
+	require.ErrorContains(err, "out of the offer range")
+	depositResp, err := cli.Deposit(ctx, offer.Id, 10, 60)
+	require.NoError(err)
+	offersResp, err = cli.GetDepositOffers(ctx)
+	require.NoError(err)
+	require.Equal(uint64(10), offersResp.DepositOffers[2].DepositedAmount)
+
+	// nothing is unlockable until the deposit ends
+	_, err = cli.UnlockDeposit(ctx, []string{depositResp.TxId})
+	require.ErrorContains(err, network.ErrNothingToUnlock.Error())
+	_, err = cli.ClaimRewards(ctx, []string{ids.GenerateTestID().String()})
+	require.ErrorContains(err, ErrDepositNotFound.Error())
+	_, err = cli.ClaimRewards(ctx, []string{"foo"})
+	require.ErrorContains(err, "couldn't parse ID")
+}
+
+func TestMultisig(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	cli := NewClient(NewServer(), logging.NoLog{})
+	defer cli.Close()
+	ctx := context.Background()
+
+	_, err := cli.Start(ctx, execPath)
+	require.NoError(err)
+
+	const (
+		addr1 = "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68"
+		addr2 = "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3"
+	)
+	_, err = cli.CreateMultisigAlias(ctx, 3, []string{addr1, addr2}, "")
+	require.ErrorContains(err, network.ErrInvalidOwners.Error())
+	aliasResp, err := cli.CreateMultisigAlias(ctx, 2, []string{addr1, addr2}, "governance")
+	require.NoError(err)
+	require.NotEmpty(aliasResp.TxId)
+	require.True(strings.HasPrefix(aliasResp.Alias, "P-kopernikus1"))
+
+	const key = "PrivateKey-ewoqjP7PxY4yr3iLTpLisriqt94hdyDFNgchSxGGztUrTXtNN"
+	_, err = cli.CreateSubnets(ctx, []*rpcpb.SubnetSpec{{
+		Owner: &rpcpb.SubnetOwner{Threshold: 2, Keys: []string{key}, Addresses: []string{aliasResp.Alias}},
+	}})
+	require.ErrorContains(err, network.ErrInvalidOwners.Error())
+	subnetsResp, err := cli.CreateSubnets(ctx, []*rpcpb.SubnetSpec{{
+		Owner: &rpcpb.SubnetOwner{Threshold: 1, Keys: []string{key}, Addresses: []string{aliasResp.Alias}},
+	}})
+	require.NoError(err)
+	require.Len(subnetsResp.SubnetIds, 1)
+}
+
+func TestCChainRoles(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	cli := NewClient(NewServer(), logging.NoLog{})
+	defer cli.Close()
+	ctx := context.Background()
+
+	const addr = "0x3fD8b9A9B8a8B24b7D3bC9E2d6ea53e5b1A5F3f1"
+	_, err := cli.GrantCChainRole(ctx, addr, "kyc")
+	require.True(server.IsServerError(err, server.ErrNotBootstrapped))
+
+	_, err = cli.Start(ctx, execPath, client.WithAdminKey("PrivateKey-foo"))
+	require.ErrorContains(err, "invalid admin key")
+	_, err = cli.Start(ctx, execPath, client.WithAdminKey("PrivateKey-ewoqjP7PxY4yr3iLTpLisriqt94hdyDFNgchSxGGztUrTXtNN"))
+	require.NoError(err)
+	_, err = cli.GrantCChainRole(ctx, addr, "minter")
+	require.ErrorContains(err, network.ErrUnknownCChainRole.Error())
+	_, err = cli.GetCChainRoles(ctx, "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68")
+	require.ErrorContains(err, "invalid C-chain address")
+
+	adminResp, err := cli.GetCChainRoles(ctx, "0x8db97C7cEcE249c2b98bDC0226Cc4C2A57BF52FC")
+	require.NoError(err)
+	require.Equal([]string{"admin"}, adminResp.RoleNames)
+
+	grantResp, err := cli.GrantCChainRole(ctx, addr, "kyc")
+	require.NoError(err)
+	require.NotEmpty(grantResp.TxHash)
+	_, err = cli.GrantCChainRole(ctx, addr, network.CChainDeployerRole)
+	require.NoError(err)
+	getResp, err := cli.GetCChainRoles(ctx, addr)
+	require.NoError(err)
+	require.Equal([]string{"kyc", "deployer"}, getResp.RoleNames)
+
+	revokeResp, err := cli.RevokeCChainRole(ctx, addr, network.CChainDeployerRole)
+	require.NoError(err)
+	require.Equal([]string{"kyc"}, revokeResp.RoleNames)
+	revokeResp, err = cli.RevokeCChainRole(ctx, addr, "kyc")
+	require.NoError(err)
+	require.Empty(revokeResp.RoleNames)
+}
+
+func TestNodeRoles(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	cli := NewClient(NewServer(), logging.NoLog{})
+	defer cli.Close()
+	ctx := context.Background()
+
+	_, err := cli.Start(ctx, execPath)
+	require.NoError(err)
+
+	_, err = cli.AddNode(ctx, "node6", execPath, client.WithNodeRole("beacon"))
+	require.ErrorContains(err, "unknown node role")
+	resp, err := cli.AddNode(ctx, "node6", execPath, client.WithNodeRole("archival"))
+	require.NoError(err)
+	require.Equal("archival", resp.ClusterInfo.NodeInfos["node6"].Role)
+	require.Equal("validator", resp.ClusterInfo.NodeInfos["node1"].Role)
+
+	_, err = cli.AddDelegator(ctx, "node6", 0, 0, "")
+	require.ErrorContains(err, local.ErrNotValidator.Error())
+	_, err = cli.CreateSubnets(ctx, []*rpcpb.SubnetSpec{{Participants: []string{"node1", "node6"}}})
+	require.ErrorContains(err, "not a validator")
+	subnetsResp, err := cli.CreateSubnets(ctx, []*rpcpb.SubnetSpec{{}})
+	require.NoError(err)
+	participants := subnetsResp.ClusterInfo.SubnetParticipants[subnetsResp.SubnetIds[0]].NodeNames
+	require.NotContains(participants, "node6")
+	require.Len(participants, len(subnetsResp.ClusterInfo.NodeNames)-1)
+}
+
+func TestBootstrapPeers(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	cli := NewClient(NewServer(), logging.NoLog{})
+	defer cli.Close()
+	ctx := context.Background()
+
+	_, err := cli.Start(ctx, execPath)
+	require.NoError(err)
+
+	_, err = cli.AddNode(ctx, "node6", execPath, client.WithBootstrapNodes([]string{"node9"}))
+	require.ErrorContains(err, "isn't running")
+	_, err = cli.AddNode(ctx, "node6", execPath, client.WithBootstrapPeers([]*rpcpb.BootstrapPeer{{Id: "foo", Ip: "127.0.0.1:9651"}}))
+	require.ErrorContains(err, "invalid bootstrap peer ID")
+	_, err = cli.PauseNode(ctx, "node2")
+	require.NoError(err)
+	_, err = cli.AddNode(ctx, "node6", execPath, client.WithBootstrapNodes([]string{"node2"}))
+	require.ErrorContains(err, "isn't running")
+
+	externalPeer := &rpcpb.BootstrapPeer{Id: ids.GenerateTestNodeID().String(), Ip: "10.0.0.1:9651"}
+	resp, err := cli.AddNode(ctx, "node6", execPath,
+		client.WithBootstrapNodes([]string{"node1"}),
+		client.WithBootstrapPeers([]*rpcpb.BootstrapPeer{externalPeer}),
+	)
+	require.NoError(err)
+	node1 := resp.ClusterInfo.NodeInfos["node1"]
+	peers := resp.ClusterInfo.NodeInfos["node6"].BootstrapPeers
+	require.Len(peers, 2)
+	require.Equal("node1", peers[0].Name)
+	require.Equal(node1.Id, peers[0].Id)
+	require.Equal(externalPeer.Id, peers[1].Id)
+	require.Equal(externalPeer.Ip, peers[1].Ip)
+	require.Empty(peers[1].Name)
+	require.Empty(node1.BootstrapPeers)
+}
+
+func TestJoinExternalNetwork(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	cli := NewClient(NewServer(), logging.NoLog{})
+	defer cli.Close()
+	ctx := context.Background()
+
+	const genesis = `{"networkID": 1002}`
+	peer := &rpcpb.BootstrapPeer{Id: ids.GenerateTestNodeID().String(), Ip: "127.0.0.1:9651"}
+	_, err := cli.JoinExternalNetwork(ctx, execPath, genesis, 1001, []*rpcpb.BootstrapPeer{peer})
+	require.ErrorContains(err, "doesn't match the network ID")
+	_, err = cli.JoinExternalNetwork(ctx, execPath, genesis, 1002, nil)
+	require.ErrorContains(err, server.ErrNoBootstrapPeers.Error())
+	_, err = cli.JoinExternalNetwork(ctx, execPath, genesis, 1002, []*rpcpb.BootstrapPeer{{Id: peer.Id, Ip: "foo"}})
+	require.ErrorContains(err, "invalid bootstrap peer IP")
+
+	resp, err := cli.JoinExternalNetwork(ctx, execPath, genesis, 1002, []*rpcpb.BootstrapPeer{peer}, client.WithNumNodes(2))
+	require.NoError(err)
+	require.Equal([]string{"node1", "node2"}, resp.ClusterInfo.NodeNames)
+	require.Len(resp.ClusterInfo.ExternalPeers, 1)
+	require.Equal(peer.Id, resp.ClusterInfo.NodeInfos["node2"].BootstrapPeers[0].Id)
+	_, err = cli.JoinExternalNetwork(ctx, execPath, genesis, 1002, []*rpcpb.BootstrapPeer{peer})
+	require.ErrorContains(err, server.ErrAlreadyBootstrapped.Error())
+
+	_, err = cli.SaveSnapshot(ctx, "joined")
+	require.NoError(err)
+	loadResp, err := cli.LoadSnapshot(ctx, "joined")
+	require.NoError(err)
+	require.Len(loadResp.ClusterInfo.ExternalPeers, 1)
+}
+
+func TestDelegators(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	cli := NewClient(NewServer(), logging.NoLog{})
+	defer cli.Close()
+	ctx := context.Background()
+
+	_, err := cli.Start(ctx, execPath)
+	require.NoError(err)
+
+	_, err = cli.AddDelegator(ctx, "node9", 0, 0, "")
+	require.ErrorContains(err, server.ErrNodeNotFound.Error())
+	_, err = cli.AddDelegator(ctx, "node1", 0, 0, "P-foo")
+	require.ErrorContains(err, "invalid address")
+	_, err = cli.AddDelegator(ctx, "node1", 0, 0, "", client.WithDelegationSubnet("foo"))
+	require.ErrorContains(err, "invalid delegation subnet ID")
+	_, err = cli.AddDelegator(ctx, "node1", 0, 0, "", client.WithDelegationSubnet(ids.GenerateTestID().String()))
+	require.ErrorContains(err, ErrSubnetNotFound.Error())
+
+	subnetsResp, err := cli.CreateSubnets(ctx, []*rpcpb.SubnetSpec{{Participants: []string{"node1"}}})
+	require.NoError(err)
+	subnetID := subnetsResp.SubnetIds[0]
+	_, err = cli.AddDelegator(ctx, "node2", 0, 0, "", client.WithDelegationSubnet(subnetID))
+	require.ErrorContains(err, local.ErrNotValidator.Error())
+
+	const rewardsOwner = "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68"
+	resp, err := cli.AddDelegator(ctx, "node1", 30_000_000_000, 24*time.Hour, rewardsOwner)
+	require.NoError(err)
+	_, err = cli.AddDelegator(ctx, "node1", 0, 0, "", client.WithDelegationSubnet(subnetID))
+	require.NoError(err)
+
+	delegators := resp.ClusterInfo.NodeInfos["node1"].Delegators
+	require.Len(delegators, 1)
+	require.Equal(resp.TxId, delegators[0].TxId)
+	require.Empty(delegators[0].SubnetId)
+	require.Equal(uint64(30_000_000_000), delegators[0].Amount)
+	require.Equal(uint64(24*time.Hour/time.Second), delegators[0].EndTime-delegators[0].StartTime)
+	require.Equal([]string{rewardsOwner}, delegators[0].RewardsOwners)
+
+	status, err := cli.Status(ctx)
+	require.NoError(err)
+	delegators = status.ClusterInfo.NodeInfos["node1"].Delegators
+	require.Len(delegators, 2)
+	require.Equal(subnetID, delegators[1].SubnetId)
+	require.Equal(uint64(minDelegatorStake), delegators[1].Amount)
+}
+
+func TestStreamStatus(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s := NewServer()
+	cli := NewClient(s, logging.NoLog{})
+	defer cli.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, err := cli.Start(ctx, execPath)
+	require.NoError(err)
+	require.NoError(s.Update(func(clusterInfo *rpcpb.ClusterInfo) {
+		clusterInfo.Healthy = false
+	}))
+
+	ch, err := cli.StreamStatus(ctx, 10*time.Millisecond)
+	require.NoError(err)
+	for i := 0; i < 2; i++ {
+		clusterInfo := <-ch
+		require.NotNil(clusterInfo)
+		require.False(clusterInfo.Healthy)
+	}
+}
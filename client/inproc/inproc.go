@@ -0,0 +1,246 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package inproc implements a client connection that dispatches RPCs
+// directly to in-process service implementations, without a gRPC transport.
+package inproc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/ava-labs/avalanche-network-runner/client"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	_ client.Conn       = (*Conn)(nil)
+	_ grpc.ClientStream = (*clientStream)(nil)
+	_ grpc.ServerStream = (*serverStream)(nil)
+
+	ErrClosed = errors.New("in-process connection closed")
+)
+
+// Conn dispatches RPCs to the handlers of the services it was created with.
+// Requests and responses are copied through the wire format and handler
+// errors are converted to gRPC status errors, as in a gRPC round trip.
+type Conn struct {
+	srv interface{}
+
+	methods map[string]grpc.MethodDesc
+	streams map[string]grpc.StreamDesc
+
+	// called once on Close
+	onClose   func() error
+	closeOnce sync.Once
+	closeErr  error
+	closed    chan struct{}
+}
+
+// NewConn returns a connection to [srv], which must implement the
+// services described by [descs]. [onClose], if non-nil, is called
+// the first time the connection is closed.
+func NewConn(srv interface{}, onClose func() error, descs ...*grpc.ServiceDesc) *Conn {
+	c := &Conn{
+		srv:     srv,
+		methods: map[string]grpc.MethodDesc{},
+		streams: map[string]grpc.StreamDesc{},
+		onClose: onClose,
+		closed:  make(chan struct{}),
+	}
+	for _, desc := range descs {
+		for _, method := range desc.Methods {
+			c.methods[fmt.Sprintf("/%s/%s", desc.ServiceName, method.MethodName)] = method
+		}
+		for _, stream := range desc.Streams {
+			c.streams[fmt.Sprintf("/%s/%s", desc.ServiceName, stream.StreamName)] = stream
+		}
+	}
+	return c
+}
+
+func (c *Conn) isClosed() bool {
+	select {
+	case <-c.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *Conn) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, _ ...grpc.CallOption) error {
+	if c.isClosed() {
+		return status.Error(codes.Canceled, ErrClosed.Error())
+	}
+	desc, ok := c.methods[method]
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "unknown method %s", method)
+	}
+	dec := func(in interface{}) error {
+		return copyMessage(args, in)
+	}
+	resp, err := desc.Handler(c.srv, ctx, dec, nil)
+	if err != nil {
+		return status.Convert(err).Err()
+	}
+	return copyMessage(resp, reply)
+}
+
+func (c *Conn) NewStream(ctx context.Context, _ *grpc.StreamDesc, method string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+	if c.isClosed() {
+		return nil, status.Error(codes.Canceled, ErrClosed.Error())
+	}
+	desc, ok := c.streams[method]
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "unknown method %s", method)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	p := &pipe{
+		ctx:    ctx,
+		toSrv:  make(chan []byte, 1),
+		toCli:  make(chan []byte),
+		done:   make(chan struct{}),
+		cancel: cancel,
+	}
+	go func() {
+		p.err = desc.Handler(c.srv, &serverStream{p: p})
+		close(p.done)
+	}()
+	return &clientStream{p: p}, nil
+}
+
+// Close rejects further requests and calls the close callback, if any.
+func (c *Conn) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+		if c.onClose != nil {
+			c.closeErr = c.onClose()
+		}
+	})
+	return c.closeErr
+}
+
+// pipe connects the client and server ends of a stream.
+type pipe struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// client to server messages, closed on CloseSend
+	toSrv         chan []byte
+	closeSendOnce sync.Once
+	// server to client messages
+	toCli chan []byte
+
+	// closed when the server handler returns, after setting [err]
+	done chan struct{}
+	err  error
+}
+
+type clientStream struct {
+	p *pipe
+}
+
+func (*clientStream) Header() (metadata.MD, error) { return metadata.MD{}, nil }
+
+func (*clientStream) Trailer() metadata.MD { return metadata.MD{} }
+
+func (cs *clientStream) CloseSend() error {
+	cs.p.closeSendOnce.Do(func() {
+		close(cs.p.toSrv)
+	})
+	return nil
+}
+
+func (cs *clientStream) Context() context.Context { return cs.p.ctx }
+
+func (cs *clientStream) SendMsg(m interface{}) error {
+	b, err := proto.Marshal(m.(proto.Message))
+	if err != nil {
+		return err
+	}
+	select {
+	case cs.p.toSrv <- b:
+		return nil
+	case <-cs.p.done:
+		return io.EOF
+	case <-cs.p.ctx.Done():
+		return status.FromContextError(cs.p.ctx.Err()).Err()
+	}
+}
+
+func (cs *clientStream) RecvMsg(m interface{}) error {
+	select {
+	case b := <-cs.p.toCli:
+		return proto.Unmarshal(b, m.(proto.Message))
+	case <-cs.p.done:
+		defer cs.p.cancel()
+		if cs.p.err != nil {
+			return status.Convert(cs.p.err).Err()
+		}
+		return io.EOF
+	case <-cs.p.ctx.Done():
+		return status.FromContextError(cs.p.ctx.Err()).Err()
+	}
+}
+
+type serverStream struct {
+	p *pipe
+}
+
+func (*serverStream) SetHeader(metadata.MD) error { return nil }
+
+func (*serverStream) SendHeader(metadata.MD) error { return nil }
+
+func (*serverStream) SetTrailer(metadata.MD) {}
+
+func (ss *serverStream) Context() context.Context { return ss.p.ctx }
+
+func (ss *serverStream) SendMsg(m interface{}) error {
+	b, err := proto.Marshal(m.(proto.Message))
+	if err != nil {
+		return err
+	}
+	select {
+	case ss.p.toCli <- b:
+		return nil
+	case <-ss.p.ctx.Done():
+		return status.FromContextError(ss.p.ctx.Err()).Err()
+	}
+}
+
+func (ss *serverStream) RecvMsg(m interface{}) error {
+	select {
+	case b, ok := <-ss.p.toSrv:
+		if !ok {
+			return io.EOF
+		}
+		return proto.Unmarshal(b, m.(proto.Message))
+	case <-ss.p.ctx.Done():
+		return status.FromContextError(ss.p.ctx.Err()).Err()
+	}
+}
+
+// copyMessage copies [src] into [dst] through the wire format,
+// as a gRPC round trip would.
+func copyMessage(src interface{}, dst interface{}) error {
+	srcMsg, ok := src.(proto.Message)
+	if !ok {
+		return fmt.Errorf("expected proto.Message but got %T", src)
+	}
+	dstMsg, ok := dst.(proto.Message)
+	if !ok {
+		return fmt.Errorf("expected proto.Message but got %T", dst)
+	}
+	b, err := proto.Marshal(srcMsg)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(b, dstMsg)
+}
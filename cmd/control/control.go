@@ -10,11 +10,15 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/ava-labs/avalanche-network-runner/client"
 	"github.com/ava-labs/avalanche-network-runner/local"
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/network/node"
 	"github.com/ava-labs/avalanche-network-runner/rpcpb"
 	"github.com/ava-labs/avalanche-network-runner/utils/constants"
 	"github.com/ava-labs/avalanche-network-runner/ux"
@@ -52,6 +56,7 @@ func NewCommand() *cobra.Command {
 	cmd.AddCommand(
 		newRPCVersionCommand(),
 		newStartCommand(),
+		newJoinExternalNetworkCommand(),
 		newCreateBlockchainsCommand(),
 		newCreateSubnetsCommand(),
 		newHealthCommand(),
@@ -71,6 +76,26 @@ func NewCommand() *cobra.Command {
 		newLoadSnapshotCommand(),
 		newRemoveSnapshotCommand(),
 		newGetSnapshotNamesCommand(),
+		newMigrateSnapshotCommand(),
+		newRegisterBinaryCommand(),
+		newListBinariesCommand(),
+		newRemoveBinaryCommand(),
+		newUploadArtifactCommand(),
+		newReplaceVMPluginCommand(),
+		newWatchPluginCommand(),
+		newUpdateChainConfigCommand(),
+		newUpdateSubnetConfigCommand(),
+		newSetAddressStateCommand(),
+		newGetAddressStateCommand(),
+		newGetDepositOffersCommand(),
+		newDepositCommand(),
+		newClaimRewardsCommand(),
+		newUnlockDepositCommand(),
+		newCreateMultisigAliasCommand(),
+		newGrantCChainRoleCommand(),
+		newRevokeCChainRoleCommand(),
+		newGetCChainRolesCommand(),
+		newAddDelegatorCommand(),
 	)
 
 	lvl, err := logging.ToLevel(logLevel)
@@ -107,6 +132,25 @@ var (
 	subnetConfigs       string
 	reassignPortsIfUsed bool
 	dynamicPorts        bool
+	portRangeStart      uint32
+	portRangeEnd        uint32
+	nodeNamespaces      bool
+	dedupSnapshots      bool
+	snapshotKeys        bool
+	cChainPhases        string
+	validatorBondAmount uint64
+	rewardsOwner        string
+	nodeOwnerKeys       []string
+	depositOffers       string
+	fundingKeys         []string
+	fundingKeyFile      string
+	adminKey            string
+	validatorStake      uint64
+	validatorFee        uint32
+	validatorDuration   time.Duration
+	nodeRole            string
+	bootstrapNodes      []string
+	bootstrapPeers      []string
 )
 
 func newRPCVersionCommand() *cobra.Command {
@@ -148,7 +192,7 @@ func newStartCommand() *cobra.Command {
 		&caminoNodeBinPath,
 		"camino-node-path",
 		"",
-		"camino-node binary path",
+		"camino-node binary path, name of a registered binary, or uploaded artifact reference",
 	)
 	cmd.PersistentFlags().Uint32Var(
 		&numNodes,
@@ -222,6 +266,84 @@ func newStartCommand() *cobra.Command {
 		false,
 		"true to assign dynamic ports",
 	)
+	cmd.PersistentFlags().Uint32Var(
+		&portRangeStart,
+		"port-range-start",
+		0,
+		"[optional] first port of the range to assign dynamic ports from",
+	)
+	cmd.PersistentFlags().Uint32Var(
+		&portRangeEnd,
+		"port-range-end",
+		0,
+		"[optional] last port of the range to assign dynamic ports from",
+	)
+	cmd.PersistentFlags().BoolVar(
+		&nodeNamespaces,
+		"node-namespaces",
+		false,
+		"true to run each node in its own network namespace with a distinct IP (linux only, requires root)",
+	)
+	cmd.PersistentFlags().BoolVar(
+		&dedupSnapshots,
+		"dedup-snapshots",
+		false,
+		"true to save snapshots to a deduplicated, compressed store shared by all snapshots",
+	)
+	cmd.PersistentFlags().BoolVar(
+		&snapshotKeys,
+		"snapshot-keys",
+		false,
+		"true to save the private keys given inline in the snapshots of the network",
+	)
+	cmd.PersistentFlags().StringVar(
+		&cChainPhases,
+		"c-chain-phases",
+		"",
+		"[optional] JSON string of map from C-chain phase timestamp name to RFC3339 time or offset from start (e.g. '{\"sunrisePhase0BlockTimestamp\":\"10m\"}')",
+	)
+	cmd.PersistentFlags().Uint64Var(
+		&validatorBondAmount,
+		"validator-bond-amount",
+		0,
+		"[optional] nAVAX bonded by each node added as primary network validator (default: minimum validator stake)",
+	)
+	cmd.PersistentFlags().StringVar(
+		&rewardsOwner,
+		"validator-rewards-owner",
+		"",
+		"[optional] P-chain address receiving the rewards of the added validators (default: funding address)",
+	)
+	cmd.PersistentFlags().StringSliceVar(
+		&nodeOwnerKeys,
+		"node-owner-keys",
+		nil,
+		"[optional] private keys of the consortium members the added validators are registered to, one node each (default: funding keys)",
+	)
+	cmd.PersistentFlags().StringVar(
+		&depositOffers,
+		"deposit-offers",
+		"",
+		"[optional] JSON list of deposit offers added to the camino genesis, in its depositOffers format",
+	)
+	cmd.PersistentFlags().StringSliceVar(
+		&fundingKeys,
+		"funding-keys",
+		nil,
+		"[optional] private keys funding the P-chain txs, the first one receiving the change (default: EWOQ key)",
+	)
+	cmd.PersistentFlags().StringVar(
+		&fundingKeyFile,
+		"funding-key-file",
+		"",
+		"[optional] server path of a file with further funding keys, one per line",
+	)
+	cmd.PersistentFlags().StringVar(
+		&adminKey,
+		"admin-key",
+		"",
+		"[optional] private key holding the admin role of the P-chain and the C-chain (default: initial admins of the local genesis)",
+	)
 	if err := cmd.MarkPersistentFlagRequired("camino-node-path"); err != nil {
 		panic(err)
 	}
@@ -242,6 +364,23 @@ func startFunc(*cobra.Command, []string) error {
 		client.WithRootDataDir(rootDataDir),
 		client.WithReassignPortsIfUsed(reassignPortsIfUsed),
 		client.WithDynamicPorts(dynamicPorts),
+		client.WithPortRange(portRangeStart, portRangeEnd),
+		client.WithNodeNamespaces(nodeNamespaces),
+		client.WithDedupSnapshots(dedupSnapshots),
+		client.WithSnapshotKeys(snapshotKeys),
+		client.WithValidatorBond(validatorBondAmount, rewardsOwner),
+		client.WithNodeOwnerKeys(nodeOwnerKeys),
+		client.WithDepositOffers(depositOffers),
+		client.WithFundingKeys(fundingKeys, fundingKeyFile),
+		client.WithAdminKey(adminKey),
+	}
+
+	if cChainPhases != "" {
+		cChainPhasesMap := map[string]string{}
+		if err := json.Unmarshal([]byte(cChainPhases), &cChainPhasesMap); err != nil {
+			return err
+		}
+		opts = append(opts, client.WithUpgradeSchedule(cChainPhasesMap))
 	}
 
 	if globalNodeConfig != "" {
@@ -307,6 +446,150 @@ func startFunc(*cobra.Command, []string) error {
 	return nil
 }
 
+var (
+	joinNumNodes      uint32
+	genesisFile       string
+	externalNetworkID uint32
+	bootstrapIDs      string
+	bootstrapIPs      string
+)
+
+func newJoinExternalNetworkCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "join-external-network [options]",
+		Short: "Starts nodes joining an external network, such as one of another server.",
+		RunE:  joinExternalNetworkFunc,
+		Args:  cobra.ExactArgs(0),
+	}
+	cmd.PersistentFlags().StringVar(
+		&caminoNodeBinPath,
+		"camino-node-path",
+		"",
+		"camino-node binary path, name of a registered binary, or uploaded artifact reference",
+	)
+	cmd.PersistentFlags().Uint32Var(
+		&joinNumNodes,
+		"number-of-nodes",
+		1,
+		"number of nodes joining the network",
+	)
+	cmd.PersistentFlags().StringVar(
+		&genesisFile,
+		"genesis-file",
+		"",
+		"genesis file of the external network",
+	)
+	cmd.PersistentFlags().Uint32Var(
+		&externalNetworkID,
+		"network-id",
+		0,
+		"network ID of the external network, matching its genesis",
+	)
+	cmd.PersistentFlags().StringVar(
+		&bootstrapIDs,
+		"bootstrap-ids",
+		"",
+		"node IDs of the external network peers to bootstrap from (comma-separated)",
+	)
+	cmd.PersistentFlags().StringVar(
+		&bootstrapIPs,
+		"bootstrap-ips",
+		"",
+		"staking IPs of the external network peers to bootstrap from, in the order of their IDs (comma-separated)",
+	)
+	cmd.PersistentFlags().StringVar(
+		&pluginDir,
+		"plugin-dir",
+		"",
+		"[optional] plugin directory",
+	)
+	cmd.PersistentFlags().StringVar(
+		&rootDataDir,
+		"root-data-dir",
+		"",
+		"[optional] root data directory to store logs and configurations",
+	)
+	cmd.PersistentFlags().StringVar(
+		&globalNodeConfig,
+		"global-node-config",
+		"",
+		"[optional] global node config as JSON string, applied to all nodes",
+	)
+	cmd.PersistentFlags().StringVar(
+		&trackSubnets,
+		"whitelisted-subnets",
+		"",
+		"[optional] whitelisted subnets (comma-separated)",
+	)
+	cmd.PersistentFlags().StringVar(
+		&chainConfigs,
+		"chain-configs",
+		"",
+		"[optional] JSON string of map from chain id to its config file contents",
+	)
+	return cmd
+}
+
+func joinExternalNetworkFunc(*cobra.Command, []string) error {
+	genesis, err := os.ReadFile(genesisFile)
+	if err != nil {
+		return fmt.Errorf("couldn't read genesis file: %w", err)
+	}
+	nodeIDs, nodeIPs := strings.Split(bootstrapIDs, ","), strings.Split(bootstrapIPs, ",")
+	if bootstrapIDs == "" || len(nodeIDs) != len(nodeIPs) {
+		return fmt.Errorf("%d bootstrap IPs given for the bootstrap IDs %q", len(nodeIPs), bootstrapIDs)
+	}
+	bootstrapPeers := make([]*rpcpb.BootstrapPeer, len(nodeIDs))
+	for i := range nodeIDs {
+		bootstrapPeers[i] = &rpcpb.BootstrapPeer{Id: nodeIDs[i], Ip: nodeIPs[i]}
+	}
+
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	opts := []client.OpOption{
+		client.WithNumNodes(joinNumNodes),
+		client.WithPluginDir(pluginDir),
+		client.WithTrackSubnets(trackSubnets),
+		client.WithRootDataDir(rootDataDir),
+	}
+	if globalNodeConfig != "" {
+		// validate it's valid JSON
+		var js json.RawMessage
+		if err := json.Unmarshal([]byte(globalNodeConfig), &js); err != nil {
+			return fmt.Errorf("failed to validate JSON for provided config file: %w", err)
+		}
+		opts = append(opts, client.WithGlobalNodeConfig(globalNodeConfig))
+	}
+	if chainConfigs != "" {
+		chainConfigsMap := make(map[string]string)
+		if err := json.Unmarshal([]byte(chainConfigs), &chainConfigsMap); err != nil {
+			return err
+		}
+		opts = append(opts, client.WithChainConfigs(chainConfigsMap))
+	}
+
+	ctx := getAsyncContext()
+
+	info, err := cli.JoinExternalNetwork(
+		ctx,
+		caminoNodeBinPath,
+		string(genesis),
+		externalNetworkID,
+		bootstrapPeers,
+		opts...,
+	)
+	if err != nil {
+		return err
+	}
+
+	ux.Print(log, logging.Green.Wrap("join external network response: %+v"), info)
+	return nil
+}
+
 func newCreateBlockchainsCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-blockchains blockchain-specs [options]",
@@ -649,7 +932,7 @@ func newAddNodeCommand() *cobra.Command {
 		&caminoNodeBinPath,
 		"camino-node-path",
 		"",
-		"camino-node binary path",
+		"camino-node binary path, name of a registered binary, or uploaded artifact reference",
 	)
 	cmd.PersistentFlags().StringVar(
 		&addNodeConfig,
@@ -681,10 +964,46 @@ func newAddNodeCommand() *cobra.Command {
 		"",
 		"[optional] JSON string of map from subnet id to its config file contents",
 	)
+	cmd.PersistentFlags().Uint64Var(
+		&validatorStake,
+		"validator-stake",
+		0,
+		"[optional] nAVAX staked or bonded by the node as primary network validator, defaults to the network validator bond",
+	)
+	cmd.PersistentFlags().Uint32Var(
+		&validatorFee,
+		"validator-delegation-fee",
+		0,
+		"[optional] delegation fee of the node as primary network validator, in parts per million, defaults to 10%",
+	)
+	cmd.PersistentFlags().DurationVar(
+		&validatorDuration,
+		"validator-duration",
+		0,
+		"[optional] primary network validation duration of the node, defaults to a year",
+	)
+	cmd.PersistentFlags().StringVar(
+		&nodeRole,
+		"role",
+		"",
+		fmt.Sprintf("[optional] role of the node, one of %v, defaults to validator", node.Roles),
+	)
+	cmd.PersistentFlags().StringSliceVar(
+		&bootstrapNodes,
+		"bootstrap-nodes",
+		nil,
+		"[optional] running nodes to bootstrap from, defaults to the beacons",
+	)
+	cmd.PersistentFlags().StringSliceVar(
+		&bootstrapPeers,
+		"bootstrap-peers",
+		nil,
+		"[optional] peers outside the network to bootstrap from, as NodeID-...@ip:port",
+	)
 	return cmd
 }
 
-func addNodeFunc(_ *cobra.Command, args []string) error {
+func addNodeFunc(cmd *cobra.Command, args []string) error {
 	// no validation for empty string required, as covered by `cobra.ExactArgs`
 	nodeName := args[0]
 	cli, err := newClient()
@@ -695,6 +1014,15 @@ func addNodeFunc(_ *cobra.Command, args []string) error {
 
 	opts := []client.OpOption{
 		client.WithPluginDir(pluginDir),
+		client.WithNodeRole(nodeRole),
+		client.WithBootstrapNodes(bootstrapNodes),
+	}
+	if len(bootstrapPeers) > 0 {
+		peers, err := parseBootstrapPeers(bootstrapPeers)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, client.WithBootstrapPeers(peers))
 	}
 
 	if addNodeConfig != "" {
@@ -728,6 +1056,18 @@ func addNodeFunc(_ *cobra.Command, args []string) error {
 		}
 		opts = append(opts, client.WithSubnetConfigs(subnetConfigsMap))
 	}
+	// a delegation fee of 0 is only set if given
+	feeGiven := cmd.Flags().Changed("validator-delegation-fee")
+	if validatorStake != 0 || feeGiven || validatorDuration != 0 {
+		validatorSpec := &rpcpb.ValidatorSpec{Stake: validatorStake}
+		if feeGiven {
+			validatorSpec.DelegationFee = &validatorFee
+		}
+		if validatorDuration != 0 {
+			validatorSpec.Duration = validatorDuration.String()
+		}
+		opts = append(opts, client.WithValidatorSpec(validatorSpec))
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
 	info, err := cli.AddNode(
@@ -756,7 +1096,7 @@ func newRestartNodeCommand() *cobra.Command {
 		&caminoNodeBinPath,
 		"camino-node-path",
 		"",
-		"camino-node binary path",
+		"camino-node binary path, name of a registered binary, or uploaded artifact reference",
 	)
 	cmd.PersistentFlags().StringVar(
 		&trackSubnets,
@@ -1009,7 +1349,7 @@ func newLoadSnapshotCommand() *cobra.Command {
 		&caminoNodeBinPath,
 		"camino-node-path",
 		"",
-		"camino-node binary path",
+		"camino-node binary path, name of a registered binary, or uploaded artifact reference",
 	)
 	cmd.PersistentFlags().StringVar(
 		&pluginDir,
@@ -1169,6 +1509,745 @@ func getSnapshotNamesFunc(*cobra.Command, []string) error {
 	return nil
 }
 
+func newMigrateSnapshotCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate-snapshot snapshot-name",
+		Short: "Requests server to upgrade a network snapshot to the current format, in place.",
+		RunE:  migrateSnapshotFunc,
+		Args:  cobra.ExactArgs(1),
+	}
+}
+
+func migrateSnapshotFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	resp, err := cli.MigrateSnapshot(ctx, args[0])
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	ux.Print(log, logging.Green.Wrap("migrate-snapshot response: %+v"), resp)
+	return nil
+}
+
+func newRegisterBinaryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-binary binary-name camino-node-path [options]",
+		Short: "Requests server to store a camino-node binary under a name, usable in place of its path.",
+		RunE:  registerBinaryFunc,
+		Args:  cobra.ExactArgs(2),
+	}
+	cmd.PersistentFlags().StringVar(
+		&pluginDir,
+		"plugin-dir",
+		"",
+		"plugin directory stored with the binary",
+	)
+	return cmd
+}
+
+func registerBinaryFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	resp, err := cli.RegisterBinary(ctx, args[0], args[1], client.WithPluginDir(pluginDir))
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	ux.Print(log, logging.Green.Wrap("register-binary response: %+v"), resp)
+	return nil
+}
+
+func newListBinariesCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list-binaries",
+		Short: "Requests server to list the registered camino-node binaries.",
+		RunE:  listBinariesFunc,
+		Args:  cobra.ExactArgs(0),
+	}
+}
+
+func listBinariesFunc(*cobra.Command, []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	binaryInfos, err := cli.ListBinaries(ctx)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	ux.Print(log, logging.Green.Wrap("list-binaries response: %+v"), binaryInfos)
+	return nil
+}
+
+func newRemoveBinaryCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-binary binary-name",
+		Short: "Requests server to remove a registered camino-node binary.",
+		RunE:  removeBinaryFunc,
+		Args:  cobra.ExactArgs(1),
+	}
+}
+
+func removeBinaryFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	resp, err := cli.RemoveBinary(ctx, args[0])
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	ux.Print(log, logging.Green.Wrap("remove-binary response: %+v"), resp)
+	return nil
+}
+
+func newUploadArtifactCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "upload-artifact path [path...]",
+		Short: "Uploads files or dirs to the server, to be referred to in place of server paths.",
+		RunE:  uploadArtifactFunc,
+		Args:  cobra.MinimumNArgs(1),
+	}
+}
+
+func uploadArtifactFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	for _, path := range args {
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		resp, err := cli.UploadArtifact(ctx, path)
+		cancel()
+		if err != nil {
+			return err
+		}
+		ux.Print(log, logging.Green.Wrap("upload-artifact response for %s: %+v"), path, resp)
+	}
+	return nil
+}
+
+var (
+	uploadPlugin       bool
+	pluginPollInterval time.Duration
+)
+
+func newReplaceVMPluginCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replace-vm-plugin vm-name plugin-path [options]",
+		Short: "Replaces the plugin of a VM and restarts the nodes tracking the subnets running it.",
+		RunE:  replaceVMPluginFunc,
+		Args:  cobra.ExactArgs(2),
+	}
+	cmd.PersistentFlags().BoolVar(
+		&uploadPlugin,
+		"upload",
+		false,
+		"[optional] upload the local plugin to the server instead of using a server path",
+	)
+	return cmd
+}
+
+func replaceVMPluginFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	return replaceVMPlugin(ctx, cli, args[0], args[1])
+}
+
+func newWatchPluginCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch-plugin vm-name plugin-path [options]",
+		Short: "Replaces the plugin of a VM whenever the plugin file changes, until interrupted.",
+		RunE:  watchPluginFunc,
+		Args:  cobra.ExactArgs(2),
+	}
+	cmd.PersistentFlags().BoolVar(
+		&uploadPlugin,
+		"upload",
+		false,
+		"[optional] upload the local plugin to the server instead of using a server path",
+	)
+	cmd.PersistentFlags().DurationVar(
+		&pluginPollInterval,
+		"poll-interval",
+		time.Second,
+		"interval to check the plugin file for changes",
+	)
+	return cmd
+}
+
+func watchPluginFunc(_ *cobra.Command, args []string) error {
+	vmName, pluginPath := args[0], args[1]
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigc)
+
+	// last replaced plugin, the one present at start counts as replaced
+	last, err := os.Stat(pluginPath)
+	if err != nil {
+		return err
+	}
+	// changed plugin seen at the previous check, replaced once it stops changing,
+	// so that a plugin being written by a build is not picked up
+	var changed os.FileInfo
+
+	ux.Print(log, logging.Blue.Wrap("watching %s for changes..."), pluginPath)
+	ticker := time.NewTicker(pluginPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case sig := <-sigc:
+			log.Warn("received signal", zap.String("signal", sig.String()))
+			return nil
+		case <-ticker.C:
+		}
+		info, err := os.Stat(pluginPath)
+		if err != nil {
+			// the build may be rewriting the plugin
+			log.Debug("couldn't stat plugin", zap.Error(err))
+			continue
+		}
+		if sameFileInfo(info, last) {
+			changed = nil
+			continue
+		}
+		if changed == nil || !sameFileInfo(info, changed) {
+			changed = info
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		err = replaceVMPlugin(ctx, cli, vmName, pluginPath)
+		cancel()
+		if err != nil {
+			// keep watching for a fixed build
+			log.Error("failed to replace vm plugin", zap.Error(err))
+		}
+		last, changed = info, nil
+	}
+}
+
+// Returns true if [a] and [b] have the same size and modification time.
+func sameFileInfo(a os.FileInfo, b os.FileInfo) bool {
+	return a.Size() == b.Size() && a.ModTime().Equal(b.ModTime())
+}
+
+// Replaces the plugin of [vmName] by the plugin at [pluginPath], uploaded
+// first if [uploadPlugin] is set.
+func replaceVMPlugin(ctx context.Context, cli client.Client, vmName string, pluginPath string) error {
+	if uploadPlugin {
+		uploadResp, err := cli.UploadArtifact(ctx, pluginPath)
+		if err != nil {
+			return err
+		}
+		pluginPath = uploadResp.Ref
+	}
+	resp, err := cli.ReplaceVMPlugin(ctx, vmName, pluginPath)
+	if err != nil {
+		return err
+	}
+	ux.Print(log, logging.Green.Wrap("replace-vm-plugin response: %+v"), resp)
+	return nil
+}
+
+var perNodeChainConfig string
+
+func newUpdateChainConfigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-chain-config chain-id [chain-config] [options]",
+		Short: "Updates the config of a blockchain, restarting the nodes tracking it whose config changed.",
+		RunE:  updateChainConfigFunc,
+		Args:  cobra.RangeArgs(1, 2),
+	}
+	cmd.PersistentFlags().StringVar(
+		&perNodeChainConfig,
+		"per-node-chain-config",
+		"",
+		"[optional] JSON string of map from node name to its chain config contents",
+	)
+	return cmd
+}
+
+func updateChainConfigFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	chainConfig := ""
+	if len(args) > 1 {
+		chainConfig = args[1]
+	}
+	perNodeChainConfigMap := map[string]string{}
+	if perNodeChainConfig != "" {
+		if err := json.Unmarshal([]byte(perNodeChainConfig), &perNodeChainConfigMap); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := cli.UpdateChainConfig(ctx, args[0], chainConfig, perNodeChainConfigMap)
+	if err != nil {
+		return err
+	}
+	ux.Print(log, logging.Green.Wrap("update-chain-config response: %+v"), resp)
+	return nil
+}
+
+func newUpdateSubnetConfigCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-subnet-config subnet-id subnet-config",
+		Short: "Updates the config of a subnet, restarting the nodes tracking it whose config changed.",
+		RunE:  updateSubnetConfigFunc,
+		Args:  cobra.ExactArgs(2),
+	}
+}
+
+func updateSubnetConfigFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := cli.UpdateSubnetConfig(ctx, args[0], args[1])
+	if err != nil {
+		return err
+	}
+	ux.Print(log, logging.Green.Wrap("update-subnet-config response: %+v"), resp)
+	return nil
+}
+
+var removeAddressState bool
+
+func newSetAddressStateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-address-state address state [options]",
+		Short: "Sets an address state, such as kycVerified or consortiumMember, on a P-chain address.",
+		Long: fmt.Sprintf(
+			"Sets an address state on a P-chain address, with a tx signed by the genesis admin key.\nStates: %s",
+			strings.Join(network.AddressStateNames(^uint64(0)), ", "),
+		),
+		RunE: setAddressStateFunc,
+		Args: cobra.ExactArgs(2),
+	}
+	cmd.PersistentFlags().BoolVar(
+		&removeAddressState,
+		"remove",
+		false,
+		"[optional] unset the state instead of setting it",
+	)
+	return cmd
+}
+
+func setAddressStateFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := cli.SetAddressState(ctx, args[0], args[1], removeAddressState)
+	if err != nil {
+		return err
+	}
+	ux.Print(log, logging.Green.Wrap("set-address-state response: %+v"), resp)
+	return nil
+}
+
+func newGetAddressStateCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-address-state address",
+		Short: "Gets the address states of a P-chain address.",
+		RunE:  getAddressStateFunc,
+		Args:  cobra.ExactArgs(1),
+	}
+}
+
+func getAddressStateFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := cli.GetAddressState(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	ux.Print(log, logging.Green.Wrap("get-address-state response: %+v"), resp)
+	return nil
+}
+
+func newGetDepositOffersCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-deposit-offers",
+		Short: "Gets the deposit offers active at the P-chain time.",
+		RunE:  getDepositOffersFunc,
+		Args:  cobra.ExactArgs(0),
+	}
+}
+
+func getDepositOffersFunc(*cobra.Command, []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := cli.GetDepositOffers(ctx)
+	if err != nil {
+		return err
+	}
+	ux.Print(log, logging.Green.Wrap("get-deposit-offers response: %+v"), resp)
+	return nil
+}
+
+func newDepositCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit deposit-offer-id amount duration",
+		Short: "Deposits nAVAX of the funding address for a duration in seconds.",
+		RunE:  depositFunc,
+		Args:  cobra.ExactArgs(3),
+	}
+}
+
+func depositFunc(_ *cobra.Command, args []string) error {
+	amount, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid amount %q: %w", args[1], err)
+	}
+	duration, err := strconv.ParseUint(args[2], 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid duration %q: %w", args[2], err)
+	}
+
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := cli.Deposit(ctx, args[0], amount, uint32(duration))
+	if err != nil {
+		return err
+	}
+	ux.Print(log, logging.Green.Wrap("deposit response: %+v"), resp)
+	return nil
+}
+
+func newClaimRewardsCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim-rewards [deposit-tx-ids...]",
+		Short: "Claims deposit rewards, and the validator and expired deposit rewards of the funding address.",
+		RunE:  claimRewardsFunc,
+	}
+}
+
+func claimRewardsFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := cli.ClaimRewards(ctx, args)
+	if err != nil {
+		return err
+	}
+	ux.Print(log, logging.Green.Wrap("claim-rewards response: %+v"), resp)
+	return nil
+}
+
+func newUnlockDepositCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "unlock-deposit deposit-tx-ids...",
+		Short: "Unlocks the unlockable amount of deposits.",
+		RunE:  unlockDepositFunc,
+		Args:  cobra.MinimumNArgs(1),
+	}
+}
+
+func unlockDepositFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := cli.UnlockDeposit(ctx, args)
+	if err != nil {
+		return err
+	}
+	ux.Print(log, logging.Green.Wrap("unlock-deposit response: %+v"), resp)
+	return nil
+}
+
+var multisigAliasMemo string
+
+func newCreateMultisigAliasCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-multisig-alias threshold addresses...",
+		Short: "Creates a multisig alias owned by a threshold of P-chain addresses.",
+		RunE:  createMultisigAliasFunc,
+		Args:  cobra.MinimumNArgs(2),
+	}
+	cmd.PersistentFlags().StringVar(
+		&multisigAliasMemo,
+		"memo",
+		"",
+		"[optional] memo of the alias",
+	)
+	return cmd
+}
+
+func createMultisigAliasFunc(_ *cobra.Command, args []string) error {
+	threshold, err := strconv.ParseUint(args[0], 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid threshold %q: %w", args[0], err)
+	}
+
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := cli.CreateMultisigAlias(ctx, uint32(threshold), args[1:], multisigAliasMemo)
+	if err != nil {
+		return err
+	}
+	ux.Print(log, logging.Green.Wrap("create-multisig-alias response: %+v"), resp)
+	return nil
+}
+
+// Parses bootstrap peers given as NodeID-...@ip:port.
+func parseBootstrapPeers(peers []string) ([]*rpcpb.BootstrapPeer, error) {
+	bootstrapPeers := make([]*rpcpb.BootstrapPeer, 0, len(peers))
+	for _, peer := range peers {
+		nodeID, ip, ok := strings.Cut(peer, "@")
+		if !ok {
+			return nil, fmt.Errorf("invalid bootstrap peer %q, expected NodeID-...@ip:port", peer)
+		}
+		bootstrapPeer := node.BootstrapPeer{ID: nodeID, IP: ip}
+		if _, _, err := bootstrapPeer.Parse(); err != nil {
+			return nil, err
+		}
+		bootstrapPeers = append(bootstrapPeers, &rpcpb.BootstrapPeer{Id: nodeID, Ip: ip})
+	}
+	return bootstrapPeers, nil
+}
+
+// Returns the names of the C-chain roles, for command help.
+func cChainRoleNames() string {
+	return strings.Join(network.CChainRoleNames(^uint64(0), true), ", ")
+}
+
+func newGrantCChainRoleCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "grant-cchain-role address role",
+		Short: "Grants a C-chain role, such as kyc or deployer, to a C-chain address.",
+		Long: fmt.Sprintf(
+			"Grants a C-chain role to a C-chain address, with a tx signed by the genesis admin key.\nRoles: %s",
+			cChainRoleNames(),
+		),
+		RunE: grantCChainRoleFunc,
+		Args: cobra.ExactArgs(2),
+	}
+}
+
+func grantCChainRoleFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := cli.GrantCChainRole(ctx, args[0], args[1])
+	if err != nil {
+		return err
+	}
+	ux.Print(log, logging.Green.Wrap("grant-cchain-role response: %+v"), resp)
+	return nil
+}
+
+func newRevokeCChainRoleCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-cchain-role address role",
+		Short: "Revokes a C-chain role, such as kyc or deployer, from a C-chain address.",
+		Long: fmt.Sprintf(
+			"Revokes a C-chain role from a C-chain address, with a tx signed by the genesis admin key.\nRoles: %s",
+			cChainRoleNames(),
+		),
+		RunE: revokeCChainRoleFunc,
+		Args: cobra.ExactArgs(2),
+	}
+}
+
+func revokeCChainRoleFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := cli.RevokeCChainRole(ctx, args[0], args[1])
+	if err != nil {
+		return err
+	}
+	ux.Print(log, logging.Green.Wrap("revoke-cchain-role response: %+v"), resp)
+	return nil
+}
+
+func newGetCChainRolesCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-cchain-roles address",
+		Short: "Gets the C-chain roles of a C-chain address.",
+		RunE:  getCChainRolesFunc,
+		Args:  cobra.ExactArgs(1),
+	}
+}
+
+func getCChainRolesFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := cli.GetCChainRoles(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	ux.Print(log, logging.Green.Wrap("get-cchain-roles response: %+v"), resp)
+	return nil
+}
+
+var (
+	delegationSubnetID     string
+	delegationAmount       uint64
+	delegationDuration     time.Duration
+	delegationRewardsOwner string
+)
+
+func newAddDelegatorCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-delegator node-name [options]",
+		Short: "Delegates to a validator from the funds of the funding addresses.",
+		RunE:  addDelegatorFunc,
+		Args:  cobra.ExactArgs(1),
+	}
+	cmd.PersistentFlags().StringVar(
+		&delegationSubnetID,
+		"subnet-id",
+		"",
+		"[optional] elastic subnet of the validation to delegate to, defaults to the primary network",
+	)
+	cmd.PersistentFlags().Uint64Var(
+		&delegationAmount,
+		"amount",
+		0,
+		"[optional] nAVAX, or units of the subnet staking asset, to delegate, defaults to the minimum delegator stake",
+	)
+	cmd.PersistentFlags().DurationVar(
+		&delegationDuration,
+		"duration",
+		0,
+		"[optional] delegation duration, defaults to the rest of the validation",
+	)
+	cmd.PersistentFlags().StringVar(
+		&delegationRewardsOwner,
+		"rewards-owner",
+		"",
+		"[optional] P-chain address receiving the rewards, defaults to the first funding address",
+	)
+	return cmd
+}
+
+func addDelegatorFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := cli.AddDelegator(
+		ctx,
+		args[0],
+		delegationAmount,
+		delegationDuration,
+		delegationRewardsOwner,
+		client.WithDelegationSubnet(delegationSubnetID),
+	)
+	if err != nil {
+		return err
+	}
+	ux.Print(log, logging.Green.Wrap("add-delegator response: %+v"), resp)
+	return nil
+}
+
 func newClient() (client.Client, error) {
 	return client.New(client.Config{
 		Endpoint:    endpoint,
@@ -10,16 +10,15 @@ import (
 	"github.com/ava-labs/avalanche-network-runner/cmd/control"
 	"github.com/ava-labs/avalanche-network-runner/cmd/ping"
 	"github.com/ava-labs/avalanche-network-runner/cmd/server"
+	"github.com/ava-labs/avalanche-network-runner/utils/constants"
 	"github.com/spf13/cobra"
 )
 
-var Version = ""
-
 var rootCmd = &cobra.Command{
 	Use:        "camino-network-runner",
 	Short:      "camino-network-runner commands",
 	SuggestFor: []string{"network-runner"},
-	Version:    Version,
+	Version:    constants.Version,
 }
 
 func init() {
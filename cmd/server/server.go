@@ -31,6 +31,8 @@ var (
 	dialTimeout        time.Duration
 	disableNodesOutput bool
 	snapshotsDir       string
+	binariesDir        string
+	artifactsDir       string
 )
 
 func NewCommand() *cobra.Command {
@@ -49,6 +51,8 @@ func NewCommand() *cobra.Command {
 	cmd.PersistentFlags().DurationVar(&dialTimeout, "dial-timeout", 10*time.Second, "server dial timeout")
 	cmd.PersistentFlags().BoolVar(&disableNodesOutput, "disable-nodes-output", false, "true to disable nodes stdout/stderr")
 	cmd.PersistentFlags().StringVar(&snapshotsDir, "snapshots-dir", "", "directory for snapshots")
+	cmd.PersistentFlags().StringVar(&binariesDir, "binaries-dir", "", "directory of the registry of named camino-node binaries (default ~/.camino-network-runner/binaries)")
+	cmd.PersistentFlags().StringVar(&artifactsDir, "artifacts-dir", "", "directory of the artifacts uploaded by clients (default ~/.camino-network-runner/artifacts)")
 
 	return cmd
 }
@@ -85,6 +89,8 @@ func serverFunc(*cobra.Command, []string) (err error) {
 		DialTimeout:         dialTimeout,
 		RedirectNodesOutput: !disableNodesOutput,
 		SnapshotsDir:        snapshotsDir,
+		BinariesDir:         binariesDir,
+		ArtifactsDir:        artifactsDir,
 		LogLevel:            logLevel,
 	}, log)
 	if err != nil {
@@ -0,0 +1,121 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+	"go.uber.org/zap"
+)
+
+// See network.Network
+func (ln *localNetwork) SetAddressState(ctx context.Context, addr string, state uint8, remove bool) (ids.ID, error) {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if ln.stopCalled() {
+		return ids.Empty, network.ErrStopped
+	}
+	shortID, err := parseAddress(addr)
+	if err != nil {
+		return ids.Empty, err
+	}
+	if ln.getSomeNode() == nil {
+		return ids.Empty, errNoRunningNode
+	}
+	clientURI, err := ln.getClientURI()
+	if err != nil {
+		return ids.Empty, err
+	}
+	w, err := newWallet(ctx, clientURI, []*secp256k1.PrivateKey{ln.getAdminKey(pChainGenesisAdminKey)}, nil)
+	if err != nil {
+		return ids.Empty, err
+	}
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	txID, err := w.issueAddressStateTx(shortID, state, remove, common.WithContext(cctx), defaultPoll)
+	if err != nil {
+		return ids.Empty, fmt.Errorf("P-Wallet Tx Error %s %w, address %s", "IssueAddressStateTx", err, addr)
+	}
+	ln.log.Info("set address state",
+		zap.String("address", addr),
+		zap.Uint8("state", state),
+		zap.Bool("remove", remove),
+		zap.String("tx-ID", txID.String()),
+	)
+	return txID, nil
+}
+
+// See network.Network
+func (ln *localNetwork) GetAddressState(ctx context.Context, addr string) (uint64, error) {
+	ln.lock.RLock()
+	defer ln.lock.RUnlock()
+
+	if ln.stopCalled() {
+		return 0, network.ErrStopped
+	}
+	if _, err := parseAddress(addr); err != nil {
+		return 0, err
+	}
+	if ln.getSomeNode() == nil {
+		return 0, errNoRunningNode
+	}
+	clientURI, err := ln.getClientURI()
+	if err != nil {
+		return 0, err
+	}
+	return getAddressStates(ctx, clientURI, addr)
+}
+
+// Returns the address states of [addr], as reported by the node at [clientURI].
+func getAddressStates(ctx context.Context, clientURI string, addr string) (uint64, error) {
+	// not exposed by the platformvm client
+	requester := rpc.NewEndpointRequester(clientURI + "/ext/P")
+	states := json.Uint64(0)
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	if err := requester.SendRequest(cctx, "platform.getAddressStates", &api.JSONAddress{Address: addr}, &states); err != nil {
+		return 0, err
+	}
+	return uint64(states), nil
+}
+
+// Issues an AddressStateTx setting, or unsetting if [remove], [state] on [addr].
+func (w *wallet) issueAddressStateTx(
+	addr ids.ShortID,
+	state uint8,
+	remove bool,
+	options ...common.Option,
+) (ids.ID, error) {
+	baseTx, err := w.pCaminoBuilder.NewBaseTx(nil, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	utx := &txs.AddressStateTx{
+		BaseTx:  baseTx.BaseTx,
+		Address: addr,
+		State:   state,
+		Remove:  remove,
+	}
+	return w.pWallet.IssueUnsignedTx(utx, options...)
+}
+
+// Returns the short ID of the chain prefixed bech32 address [addr].
+func parseAddress(addr string) (ids.ShortID, error) {
+	_, _, addrBytes, err := address.Parse(addr)
+	if err != nil {
+		return ids.ShortEmpty, fmt.Errorf("invalid address %q: %w", addr, err)
+	}
+	return ids.ToShortID(addrBytes)
+}
@@ -0,0 +1,34 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressStateErrors(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	addr, err := parseAddress("P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3")
+	require.NoError(err)
+	require.Equal(genesis.VMRQKey.PublicKey().Address(), addr)
+
+	ln := &localNetwork{
+		log:   logging.NoLog{},
+		nodes: map[string]*localNode{"node1": {paused: true}},
+	}
+	ctx := context.Background()
+	_, err = ln.SetAddressState(ctx, "kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3", 0, false)
+	require.ErrorContains(err, "invalid address")
+	_, err = ln.SetAddressState(ctx, "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3", 0, false)
+	require.ErrorIs(err, errNoRunningNode)
+	_, err = ln.GetAddressState(ctx, "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3")
+	require.ErrorIs(err, errNoRunningNode)
+}
@@ -0,0 +1,243 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package binaries implements a registry of camino-node builds.
+// A build is registered under a name, such as a version or a branch, and
+// stored in the registry with its plugin dir, its hash and its --version
+// output, so that it can be referred to by name instead of by path.
+package binaries
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/utils/fastcopy"
+)
+
+const (
+	binaryFileName = "camino-node"
+	pluginsDirName = "plugins"
+	infoFileName   = "info.json"
+)
+
+var (
+	ErrInvalidName   = errors.New("invalid binary name")
+	ErrBinaryExists  = errors.New("binary already registered")
+	ErrBinaryMissing = errors.New("binary not registered")
+
+	nameRe = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+	// registry dir used if none is given
+	defaultDir string
+)
+
+func init() {
+	usr, err := user.Current()
+	if err != nil {
+		panic(err)
+	}
+	defaultDir = filepath.Join(usr.HomeDir, ".camino-network-runner", "binaries")
+}
+
+// Binary is a registered camino-node build.
+type Binary struct {
+	Name string `json:"name"`
+	// Stored binary
+	ExecPath string `json:"execPath"`
+	// Stored plugin dir, empty if none was registered
+	PluginDir string `json:"pluginDir,omitempty"`
+	// Hex encoded sha256 of the binary
+	Hash string `json:"hash"`
+	// Output of the binary for --version
+	Version      string    `json:"version"`
+	RegisteredAt time.Time `json:"registeredAt"`
+}
+
+// Registry stores the registered binaries in a dir, one subdir per name.
+// It can be shared by several processes.
+type Registry struct {
+	dir string
+}
+
+// New returns the registry at [dir], or at ~/.camino-network-runner/binaries
+// if [dir] is empty. The dir is created on the first registration.
+func New(dir string) *Registry {
+	if dir == "" {
+		dir = defaultDir
+	}
+	return &Registry{dir: dir}
+}
+
+// Register copies the binary at [execPath] and the plugin dir at [pluginDir],
+// if not empty, to the registry under [name].
+func (r *Registry) Register(name string, execPath string, pluginDir string) (Binary, error) {
+	if !nameRe.MatchString(name) {
+		return Binary{}, fmt.Errorf("%w %q", ErrInvalidName, name)
+	}
+	if _, err := os.Stat(filepath.Join(r.dir, name)); err == nil {
+		return Binary{}, fmt.Errorf("%w: %q", ErrBinaryExists, name)
+	}
+	version, err := exec.Command(execPath, "--version").Output() //nolint
+	if err != nil {
+		return Binary{}, fmt.Errorf("couldn't get version of binary %q: %w", execPath, err)
+	}
+	if err := os.MkdirAll(r.dir, 0o755); err != nil {
+		return Binary{}, err
+	}
+
+	// fill a temporary dir, renamed when complete
+	tmpDir, err := os.MkdirTemp(r.dir, "."+name+".tmp")
+	if err != nil {
+		return Binary{}, err
+	}
+	defer os.RemoveAll(tmpDir)
+	binary := Binary{
+		Name:         name,
+		ExecPath:     filepath.Join(r.dir, name, binaryFileName),
+		Version:      strings.TrimSpace(string(version)),
+		RegisteredAt: time.Now().UTC(),
+	}
+	binary.Hash, err = copyBinary(execPath, filepath.Join(tmpDir, binaryFileName))
+	if err != nil {
+		return Binary{}, fmt.Errorf("couldn't copy binary %q: %w", execPath, err)
+	}
+	if pluginDir != "" {
+		binary.PluginDir = filepath.Join(r.dir, name, pluginsDirName)
+		if _, err := fastcopy.Dir(pluginDir, filepath.Join(tmpDir, pluginsDirName), nil); err != nil {
+			return Binary{}, fmt.Errorf("couldn't copy plugin dir %q: %w", pluginDir, err)
+		}
+	}
+	infoBytes, err := json.MarshalIndent(binary, "", "  ")
+	if err != nil {
+		return Binary{}, err
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, infoFileName), infoBytes, 0o644); err != nil {
+		return Binary{}, err
+	}
+	if err := os.Rename(tmpDir, filepath.Join(r.dir, name)); err != nil {
+		// a concurrent registration of the same name won
+		if _, statErr := os.Stat(filepath.Join(r.dir, name)); statErr == nil {
+			return Binary{}, fmt.Errorf("%w: %q", ErrBinaryExists, name)
+		}
+		return Binary{}, err
+	}
+	return binary, nil
+}
+
+// Get returns the binary registered under [name].
+func (r *Registry) Get(name string) (Binary, error) {
+	if !nameRe.MatchString(name) {
+		return Binary{}, fmt.Errorf("%w %q", ErrInvalidName, name)
+	}
+	infoBytes, err := os.ReadFile(filepath.Join(r.dir, name, infoFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return Binary{}, fmt.Errorf("%w: %q", ErrBinaryMissing, name)
+	}
+	if err != nil {
+		return Binary{}, err
+	}
+	binary := Binary{}
+	if err := json.Unmarshal(infoBytes, &binary); err != nil {
+		return Binary{}, fmt.Errorf("couldn't unmarshal info of binary %q: %w", name, err)
+	}
+	// the registry dir may have been moved
+	binary.ExecPath = filepath.Join(r.dir, name, binaryFileName)
+	if binary.PluginDir != "" {
+		binary.PluginDir = filepath.Join(r.dir, name, pluginsDirName)
+	}
+	return binary, nil
+}
+
+// List returns the registered binaries, sorted by name.
+func (r *Registry) List() ([]Binary, error) {
+	binaries := []Binary{}
+	entries, err := os.ReadDir(r.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return binaries, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		// skips temporary dirs of ongoing registrations
+		if !entry.IsDir() || !nameRe.MatchString(entry.Name()) {
+			continue
+		}
+		binary, err := r.Get(entry.Name())
+		if errors.Is(err, ErrBinaryMissing) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		binaries = append(binaries, binary)
+	}
+	sort.Slice(binaries, func(i, j int) bool {
+		return binaries[i].Name < binaries[j].Name
+	})
+	return binaries, nil
+}
+
+// Remove unregisters the binary registered under [name].
+func (r *Registry) Remove(name string) error {
+	if _, err := r.Get(name); err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(r.dir, name))
+}
+
+// Resolve returns the exec path and plugin dir to use for [execPath] and
+// [pluginDir]. If [execPath] is the name of a registered binary, its stored
+// binary is used, and its stored plugin dir unless [pluginDir] is given.
+// Otherwise [execPath] and [pluginDir] are returned as is.
+func (r *Registry) Resolve(execPath string, pluginDir string) (string, string, error) {
+	if !nameRe.MatchString(execPath) {
+		return execPath, pluginDir, nil
+	}
+	binary, err := r.Get(execPath)
+	if errors.Is(err, ErrBinaryMissing) {
+		// a relative path
+		return execPath, pluginDir, nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	if pluginDir == "" {
+		pluginDir = binary.PluginDir
+	}
+	return binary.ExecPath, pluginDir, nil
+}
+
+// Copies the binary at [src] to [dst], and returns its hash.
+func copyBinary(src string, dst string) (string, error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return "", err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o755)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(out, h), in); err != nil {
+		_ = out.Close()
+		return "", err
+	}
+	if err := out.Close(); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,138 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package binaries
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Writes a fake binary printing [version] on --version, and a plugin dir,
+// and returns their paths.
+func writeTestBinary(t *testing.T, version string) (string, string) {
+	require := require.New(t)
+	dir := t.TempDir()
+	execPath := filepath.Join(dir, "camino-node")
+	require.NoError(os.WriteFile(execPath, []byte("#!/bin/sh\necho "+version+"\n"), 0o755))
+	pluginDir := filepath.Join(dir, "plugins")
+	require.NoError(os.MkdirAll(pluginDir, 0o755))
+	require.NoError(os.WriteFile(filepath.Join(pluginDir, "vm"), []byte("vm"), 0o755))
+	return execPath, pluginDir
+}
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	r := New(t.TempDir())
+	execPath, pluginDir := writeTestBinary(t, "camino-node/1.2.0")
+
+	binary, err := r.Register("v1.2.0", execPath, pluginDir)
+	require.NoError(err)
+	require.Equal("v1.2.0", binary.Name)
+	require.Equal("camino-node/1.2.0", binary.Version)
+	contents, err := os.ReadFile(execPath)
+	require.NoError(err)
+	hash := sha256.Sum256(contents)
+	require.Equal(hex.EncodeToString(hash[:]), binary.Hash)
+
+	// the registered copies don't depend on the originals
+	require.NoError(os.RemoveAll(filepath.Dir(execPath)))
+	stored, err := os.ReadFile(binary.ExecPath)
+	require.NoError(err)
+	require.Equal(contents, stored)
+	vm, err := os.ReadFile(filepath.Join(binary.PluginDir, "vm"))
+	require.NoError(err)
+	require.Equal([]byte("vm"), vm)
+
+	got, err := r.Get("v1.2.0")
+	require.NoError(err)
+	require.Equal(binary.Hash, got.Hash)
+	require.Equal(binary.ExecPath, got.ExecPath)
+	require.Equal(binary.PluginDir, got.PluginDir)
+
+	_, err = r.Register("v1.2.0", binary.ExecPath, "")
+	require.ErrorIs(err, ErrBinaryExists)
+}
+
+func TestRegisterErrors(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	r := New(filepath.Join(t.TempDir(), "binaries"))
+	execPath, _ := writeTestBinary(t, "camino-node/1.2.0")
+
+	for _, name := range []string{"", "../v1", "a/b", ".hidden"} {
+		_, err := r.Register(name, execPath, "")
+		require.ErrorIs(err, ErrInvalidName)
+	}
+	_, err := r.Register("missing", filepath.Join(t.TempDir(), "camino-node"), "")
+	require.Error(err)
+	_, err = r.Get("missing")
+	require.ErrorIs(err, ErrBinaryMissing)
+	require.ErrorIs(r.Remove("missing"), ErrBinaryMissing)
+
+	binaries, err := r.List()
+	require.NoError(err)
+	require.Empty(binaries)
+}
+
+func TestListAndRemove(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	r := New(t.TempDir())
+	for _, name := range []string{"dev-branch", "v1.2.0", "v1.1.0"} {
+		execPath, _ := writeTestBinary(t, name)
+		_, err := r.Register(name, execPath, "")
+		require.NoError(err)
+	}
+
+	binaries, err := r.List()
+	require.NoError(err)
+	names := []string{}
+	for _, binary := range binaries {
+		names = append(names, binary.Name)
+		require.Empty(binary.PluginDir)
+	}
+	require.Equal([]string{"dev-branch", "v1.1.0", "v1.2.0"}, names)
+
+	require.NoError(r.Remove("v1.1.0"))
+	binaries, err = r.List()
+	require.NoError(err)
+	require.Len(binaries, 2)
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	r := New(t.TempDir())
+	execPath, pluginDir := writeTestBinary(t, "camino-node/1.2.0")
+	binary, err := r.Register("v1.2.0", execPath, pluginDir)
+	require.NoError(err)
+
+	resolvedPath, resolvedPluginDir, err := r.Resolve("v1.2.0", "")
+	require.NoError(err)
+	require.Equal(binary.ExecPath, resolvedPath)
+	require.Equal(binary.PluginDir, resolvedPluginDir)
+
+	// a given plugin dir is kept
+	_, resolvedPluginDir, err = r.Resolve("v1.2.0", "/tmp/plugins")
+	require.NoError(err)
+	require.Equal("/tmp/plugins", resolvedPluginDir)
+
+	// paths and unregistered names are kept
+	for _, path := range []string{execPath, "camino-node", ""} {
+		resolvedPath, resolvedPluginDir, err = r.Resolve(path, pluginDir)
+		require.NoError(err)
+		require.Equal(path, resolvedPath)
+		require.Equal(pluginDir, resolvedPluginDir)
+	}
+}
@@ -19,10 +19,10 @@ import (
 	"github.com/ava-labs/avalanche-network-runner/utils"
 	"github.com/ava-labs/avalanchego/api/admin"
 	"github.com/ava-labs/avalanchego/config"
-	"github.com/ava-labs/avalanchego/genesis"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/platformvm"
@@ -39,10 +39,10 @@ import (
 const (
 	// offset of validation start from current time
 	validationStartOffset = 20 * time.Second
-	// duration for primary network validators
+	// default duration for primary network validators
 	validationDuration = 365 * 24 * time.Hour
-	// weight assigned to subnet validators
-	subnetValidatorsWeight = 1000
+	// default fee of primary network validators, 10% in parts per million
+	validatorDelegationFee = 10 * 10000
 	// check period for blockchain logs while waiting for custom chains to be ready
 	blockchainLogPullFrequency = time.Second
 	// check period while waiting for all validators to be ready
@@ -62,6 +62,18 @@ type blockchainInfo struct {
 	blockchainID ids.ID
 }
 
+// Returns the sorted names of the nodes validating the network.
+func (ln *localNetwork) getValidatorNodeNames() []string {
+	nodeNames := []string{}
+	for nodeName, node := range ln.nodes {
+		if node.GetConfig().Role.IsValidator() {
+			nodeNames = append(nodeNames, nodeName)
+		}
+	}
+	sort.Strings(nodeNames)
+	return nodeNames
+}
+
 // get an arbitrary node in the network
 func (ln *localNetwork) getSomeNode() node.Node {
 	var node node.Node
@@ -180,11 +192,6 @@ func (ln *localNetwork) installCustomChains(
 		}
 	}
 
-	w, err := newWallet(ctx, clientURI, preloadTXs)
-	if err != nil {
-		return nil, err
-	}
-
 	// get subnet specs for all new subnets to create
 	// for the list of requested blockchains, we take those that have undefined subnet id
 	// and use the provided subnet spec. if not given, use an empty default subnet spec
@@ -200,19 +207,32 @@ func (ln *localNetwork) installCustomChains(
 		}
 	}
 
-	// if no participants are given for a new subnet, assume all nodes should be participants
-	allNodeNames := maps.Keys(ln.nodes)
-	sort.Strings(allNodeNames)
+	if err := ln.addSubnetOwnerKeys(subnetSpecs); err != nil {
+		return nil, err
+	}
+	w, err := newWallet(ctx, clientURI, ln.getFundingKeys(), preloadTXs, ln.subnetOwnerKeys...)
+	if err != nil {
+		return nil, err
+	}
+
+	// if no participants are given for a new subnet, assume all validator nodes should be participants
+	validatorNodeNames := ln.getValidatorNodeNames()
 	for i := range subnetSpecs {
+		if err := subnetSpecs[i].ValidateParticipantWeights(); err != nil {
+			return nil, err
+		}
 		if len(subnetSpecs[i].Participants) == 0 {
-			subnetSpecs[i].Participants = allNodeNames
+			subnetSpecs[i].Participants = validatorNodeNames
 		}
 	}
 
 	// create new nodes
 	for _, subnetSpec := range subnetSpecs {
 		for _, nodeName := range subnetSpec.Participants {
-			_, ok := ln.nodes[nodeName]
+			participant, ok := ln.nodes[nodeName]
+			if ok && !participant.GetConfig().Role.IsValidator() {
+				return nil, fmt.Errorf("subnet participant %q is an %s node, not a validator", nodeName, participant.GetConfig().Role)
+			}
 			if !ok {
 				ln.log.Info(logging.Green.Wrap(fmt.Sprintf("adding new participant %s", nodeName)))
 				if _, err := ln.addNode(node.Config{Name: nodeName}); err != nil {
@@ -231,7 +251,7 @@ func (ln *localNetwork) installCustomChains(
 	}
 
 	// create missing subnets
-	subnetIDs, err := createSubnets(ctx, uint32(len(subnetSpecs)), w, ln.log)
+	subnetIDs, err := createSubnets(ctx, subnetSpecs, w, ln.log)
 	if err != nil {
 		return nil, err
 	}
@@ -327,24 +347,32 @@ func (ln *localNetwork) installSubnets(
 	}
 	platformCli := platformvm.NewClient(clientURI)
 
-	w, err := newWallet(ctx, clientURI, []ids.ID{})
+	if err := ln.addSubnetOwnerKeys(subnetSpecs); err != nil {
+		return nil, err
+	}
+	w, err := newWallet(ctx, clientURI, ln.getFundingKeys(), []ids.ID{}, ln.subnetOwnerKeys...)
 	if err != nil {
 		return nil, err
 	}
 
-	// if no participants are given, assume all nodes should be participants
-	allNodeNames := maps.Keys(ln.nodes)
-	sort.Strings(allNodeNames)
+	// if no participants are given, assume all validator nodes should be participants
+	validatorNodeNames := ln.getValidatorNodeNames()
 	for i := range subnetSpecs {
+		if err := subnetSpecs[i].ValidateParticipantWeights(); err != nil {
+			return nil, err
+		}
 		if len(subnetSpecs[i].Participants) == 0 {
-			subnetSpecs[i].Participants = allNodeNames
+			subnetSpecs[i].Participants = validatorNodeNames
 		}
 	}
 
 	// create new nodes
 	for _, subnetSpec := range subnetSpecs {
 		for _, nodeName := range subnetSpec.Participants {
-			_, ok := ln.nodes[nodeName]
+			participant, ok := ln.nodes[nodeName]
+			if ok && !participant.GetConfig().Role.IsValidator() {
+				return nil, fmt.Errorf("subnet participant %q is an %s node, not a validator", nodeName, participant.GetConfig().Role)
+			}
 			if !ok {
 				ln.log.Info(logging.Green.Wrap(fmt.Sprintf("adding new participant %s", nodeName)))
 				if _, err := ln.addNode(node.Config{Name: nodeName}); err != nil {
@@ -362,7 +390,7 @@ func (ln *localNetwork) installSubnets(
 		return nil, err
 	}
 
-	subnetIDs, err := createSubnets(ctx, uint32(len(subnetSpecs)), w, ln.log)
+	subnetIDs, err := createSubnets(ctx, subnetSpecs, w, ln.log)
 	if err != nil {
 		return nil, err
 	}
@@ -554,23 +582,42 @@ func (ln *localNetwork) restartNodes(
 }
 
 type wallet struct {
-	addr     ids.ShortID
+	// address of the first funding key, receiving the change
+	addr ids.ShortID
+	// first funding key
+	key *secp256k1.PrivateKey
+	// all the funding keys
+	keys     []*secp256k1.PrivateKey
 	pWallet  p.Wallet
 	pBackend p.Backend
 	pBuilder p.Builder
 	pSigner  p.Signer
+	// builds the base txs of camino txs, which burn the base tx fee
+	pCaminoBuilder p.Builder
 }
 
+// Returns a wallet funded by [keys], which also signs subnet txs with [subnetKeys].
+// The change goes to the first of [keys].
 func newWallet(
 	ctx context.Context,
 	uri string,
+	keys []*secp256k1.PrivateKey,
 	preloadTXs []ids.ID,
+	subnetKeys ...*secp256k1.PrivateKey,
 ) (*wallet, error) {
-	kc := secp256k1fx.NewKeychain(genesis.EWOQKey)
+	kc := secp256k1fx.NewKeychain(keys...)
 	pCTX, _, utxos, err := primary.FetchState(ctx, uri, kc.Addresses())
 	if err != nil {
 		return nil, err
 	}
+	for _, subnetKey := range subnetKeys {
+		kc.Add(subnetKey)
+	}
+	// only the funding utxos are fetched, and the change goes back to them
+	fundingOwner := common.WithChangeOwner(&secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{keys[0].PublicKey().Address()},
+	})
 	pClient := platformvm.NewClient(uri)
 	pTXs := make(map[ids.ID]*txs.Tx)
 	for _, id := range preloadTXs {
@@ -586,17 +633,36 @@ func newWallet(
 	}
 	pUTXOs := primary.NewChainUTXOs(constants.PlatformChainID, utxos)
 	var w wallet
-	w.addr = genesis.EWOQKey.PublicKey().Address()
+	w.addr = keys[0].PublicKey().Address()
+	w.key = keys[0]
+	w.keys = keys
 	w.pBackend = p.NewBackend(pCTX, pUTXOs, pTXs)
-	w.pBuilder = p.NewBuilder(kc.Addresses(), w.pBackend)
+	w.pBuilder = p.NewBuilderWithOptions(p.NewBuilder(kc.Addresses(), w.pBackend), fundingOwner)
 	w.pSigner = p.NewSigner(kc, w.pBackend)
 	w.pWallet = p.NewWallet(w.pBuilder, w.pSigner, pClient, w.pBackend)
+	// the builder burns the create subnet tx fee on base txs,
+	// so it is given a context where that fee is the base tx fee
+	caminoCTX := p.NewContext(
+		pCTX.NetworkID(),
+		pCTX.AVAXAssetID(),
+		pCTX.BaseTxFee(),
+		pCTX.BaseTxFee(),
+		pCTX.TransformSubnetTxFee(),
+		pCTX.CreateBlockchainTxFee(),
+		pCTX.AddPrimaryNetworkValidatorFee(),
+		pCTX.AddPrimaryNetworkDelegatorFee(),
+		pCTX.AddSubnetValidatorFee(),
+		pCTX.AddSubnetDelegatorFee(),
+	)
+	w.pCaminoBuilder = p.NewBuilderWithOptions(p.NewBuilder(kc.Addresses(), p.NewBackend(caminoCTX, pUTXOs, pTXs)), fundingOwner)
 	return &w, nil
 }
 
 // add all nodes as validators of the primary network, in case they are not
 // the validation starts as soon as possible and its duration is as long as possible, that is,
-// it is set to max accepted duration by caminogo
+// it is set to max accepted duration by caminogo.
+// On networks locking stakes as bonds, the nodes are registered and bonded with
+// the camino transactions instead.
 func (ln *localNetwork) addPrimaryValidators(
 	ctx context.Context,
 	platformCli platformvm.Client,
@@ -614,10 +680,43 @@ func (ln *localNetwork) addPrimaryValidators(
 	for _, v := range vdrs {
 		curValidators.Add(v.NodeID)
 	}
+	bondAmount, rewardsOwner, err := ln.getValidatorBond(ctx, platformCli, w)
+	if err != nil {
+		return err
+	}
+	cctx, cancel = createDefaultCtx(ctx)
+	caminoConfig, err := platformCli.GetConfiguration(cctx)
+	cancel()
+	if err != nil {
+		return err
+	}
+	needed := uint64(0)
+	for nodeName, node := range ln.nodes {
+		if node.GetConfig().Role.IsValidator() && !curValidators.Contains(node.GetNodeID()) {
+			stake, _, duration := getNodeValidation(node, bondAmount)
+			if err := checkValidationDuration(nodeName, duration, uint64(caminoConfig.MinStakeDuration)); err != nil {
+				return err
+			}
+			needed += stake + w.pBackend.AddPrimaryNetworkValidatorFee()
+		}
+	}
+	if err := w.checkBalance(ctx, needed, "adding the primary network validators"); err != nil {
+		return err
+	}
 	for nodeName, node := range ln.nodes {
 		nodeID := node.GetNodeID()
 
-		if curValidators.Contains(nodeID) {
+		if !node.GetConfig().Role.IsValidator() || curValidators.Contains(nodeID) {
+			continue
+		}
+		stake, delegationFee, duration := getNodeValidation(node, bondAmount)
+
+		if caminoConfig.LockModeBondDeposit {
+			txID, err := ln.addCaminoValidator(ctx, w, nodeName, node, stake, duration, rewardsOwner)
+			if err != nil {
+				return err
+			}
+			ln.log.Info("added node as primary subnet validator", zap.String("node-name", nodeName), zap.String("node-ID", nodeID.String()), zap.String("tx-ID", txID.String()))
 			continue
 		}
 
@@ -639,8 +738,8 @@ func (ln *localNetwork) addPrimaryValidators(
 				Validator: txs.Validator{
 					NodeID: nodeID,
 					Start:  uint64(time.Now().Add(validationStartOffset).Unix()),
-					End:    uint64(time.Now().Add(validationDuration).Unix()),
-					Wght:   genesis.LocalParams.MinValidatorStake,
+					End:    uint64(time.Now().Add(duration).Unix()),
+					Wght:   stake,
 				},
 				Subnet: ids.Empty,
 			},
@@ -648,13 +747,13 @@ func (ln *localNetwork) addPrimaryValidators(
 			w.pWallet.AVAXAssetID(),
 			&secp256k1fx.OutputOwners{
 				Threshold: 1,
-				Addrs:     []ids.ShortID{w.addr},
+				Addrs:     []ids.ShortID{rewardsOwner},
 			},
 			&secp256k1fx.OutputOwners{
 				Threshold: 1,
-				Addrs:     []ids.ShortID{w.addr},
+				Addrs:     []ids.ShortID{rewardsOwner},
 			},
-			10*10000, // 10% fee percent, times 10000 to make it as shares
+			delegationFee,
 			common.WithContext(cctx),
 		)
 		cancel()
@@ -666,23 +765,30 @@ func (ln *localNetwork) addPrimaryValidators(
 	return nil
 }
 
+// creates a subnet for each of the given specs, owned by the spec owner,
+// or by the wallet address if not given
 func createSubnets(
 	ctx context.Context,
-	numSubnets uint32,
+	subnetSpecs []network.SubnetSpec,
 	w *wallet,
 	log logging.Logger,
 ) ([]ids.ID, error) {
 	fmt.Println()
-	log.Info(logging.Green.Wrap("creating subnets"), zap.Uint32("num-subnets", numSubnets))
-	subnetIDs := make([]ids.ID, numSubnets)
-	for i := uint32(0); i < numSubnets; i++ {
-		log.Info("creating subnet tx")
+	log.Info(logging.Green.Wrap("creating subnets"), zap.Int("num-subnets", len(subnetSpecs)))
+	needed := uint64(len(subnetSpecs)) * w.pBackend.CreateSubnetTxFee()
+	if err := w.checkBalance(ctx, needed, "creating the subnets"); err != nil {
+		return nil, err
+	}
+	subnetIDs := make([]ids.ID, len(subnetSpecs))
+	for i, subnetSpec := range subnetSpecs {
+		owner, err := getSubnetOwner(subnetSpec, w.addr)
+		if err != nil {
+			return nil, err
+		}
+		log.Info("creating subnet tx", zap.Uint32("owner-threshold", owner.Threshold), zap.Int("num-owners", len(owner.Addrs)))
 		cctx, cancel := createDefaultCtx(ctx)
 		subnetID, err := w.pWallet.IssueCreateSubnetTx(
-			&secp256k1fx.OutputOwners{
-				Threshold: 1,
-				Addrs:     []ids.ShortID{w.addr},
-			},
+			owner,
 			common.WithContext(cctx),
 			defaultPoll,
 		)
@@ -746,7 +852,7 @@ func (ln *localNetwork) addSubnetValidators(
 						// reasonable delay in most/slow test environments
 						Start: uint64(time.Now().Add(validationStartOffset).Unix()),
 						End:   uint64(primaryValidatorsEndtime[nodeID].Unix()),
-						Wght:  subnetValidatorsWeight,
+						Wght:  subnetSpecs[i].GetParticipantWeight(nodeName),
 					},
 					Subnet: subnetID,
 				},
@@ -787,6 +893,9 @@ func (ln *localNetwork) waitPrimaryValidators(
 			primaryValidators.Add(v.NodeID)
 		}
 		for _, node := range ln.nodes {
+			if !node.GetConfig().Role.IsValidator() {
+				continue
+			}
 			nodeID := node.GetNodeID()
 			if isValidator := primaryValidators.Contains(nodeID); !isValidator {
 				ready = false
@@ -884,6 +993,10 @@ func createBlockchainTxs(
 ) ([]*txs.Tx, error) {
 	fmt.Println()
 	log.Info(logging.Green.Wrap("creating tx for each custom chain"))
+	needed := uint64(len(chainSpecs)) * w.pBackend.CreateBlockchainTxFee()
+	if err := w.checkBalance(ctx, needed, "creating the blockchains"); err != nil {
+		return nil, err
+	}
 	blockchainTxs := make([]*txs.Tx, len(chainSpecs))
 	for i, chainSpec := range chainSpecs {
 		vmName := chainSpec.VMName
@@ -0,0 +1,417 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/utxo"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrNoFreeNodeOwner  = errors.New("no node owner key left without a node, as each consortium member owns a single node")
+	errUnknownNodeOwner = errors.New("node is registered to a consortium member without node owner key")
+)
+
+// Returns the amount bonded by the validators added with [w], and the owner of
+// their rewards.
+func (ln *localNetwork) getValidatorBond(
+	ctx context.Context,
+	platformCli platformvm.Client,
+	w *wallet,
+) (uint64, ids.ShortID, error) {
+	amount := ln.validatorBond.Amount
+	if amount == 0 {
+		cctx, cancel := createDefaultCtx(ctx)
+		minValidatorStake, _, err := platformCli.GetMinStake(cctx, constants.PrimaryNetworkID)
+		cancel()
+		if err != nil {
+			return 0, ids.ShortEmpty, err
+		}
+		amount = minValidatorStake
+	}
+	rewardsOwner := w.addr
+	if ln.validatorBond.RewardsOwner != "" {
+		var err error
+		rewardsOwner, err = parseAddress(ln.validatorBond.RewardsOwner)
+		if err != nil {
+			return 0, ids.ShortEmpty, err
+		}
+	}
+	return amount, rewardsOwner, nil
+}
+
+// Returns the stake, delegation fee and validation duration of [node],
+// from its validator config or else the defaults, [bondAmount] for the stake.
+func getNodeValidation(node node.Node, bondAmount uint64) (uint64, uint32, time.Duration) {
+	validatorConfig := node.GetConfig().Validator
+	stake := validatorConfig.Stake
+	if stake == 0 {
+		stake = bondAmount
+	}
+	delegationFee := uint32(validatorDelegationFee)
+	if validatorConfig.DelegationFee != nil {
+		delegationFee = *validatorConfig.DelegationFee
+	}
+	duration := validatorConfig.Duration
+	if duration == 0 {
+		duration = validationDuration
+	}
+	return stake, delegationFee, duration
+}
+
+// Returns an error if the validation of [nodeName] until [duration] from now
+// would be shorter than [minStakeDuration] seconds, as it starts after
+// [validationStartOffset].
+func checkValidationDuration(nodeName string, duration time.Duration, minStakeDuration uint64) error {
+	minDuration := validationStartOffset + time.Duration(minStakeDuration)*time.Second
+	if duration < minDuration {
+		return fmt.Errorf(
+			"validation duration %s of node %q is below the minimum %s of the network",
+			duration,
+			nodeName,
+			minDuration,
+		)
+	}
+	return nil
+}
+
+// Adds [node] as primary network validator on a network locking stakes as
+// bonds, until [duration] from now. The node is registered, if it isn't yet,
+// to the first node owner key not owning a node, which is made a consortium
+// member by the genesis admin if needed. [amount] is then bonded for it from
+// the funds of [w].
+func (ln *localNetwork) addCaminoValidator(
+	ctx context.Context,
+	w *wallet,
+	nodeName string,
+	node *localNode,
+	amount uint64,
+	duration time.Duration,
+	rewardsOwner ids.ShortID,
+) (ids.ID, error) {
+	nodeID := node.GetNodeID()
+	nodeKey, err := utils.ToNodeKey([]byte(node.GetConfig().StakingKey), []byte(node.GetConfig().StakingCert))
+	if err != nil {
+		return ids.Empty, fmt.Errorf("node %q: %w", nodeName, err)
+	}
+	clientURI, err := ln.getClientURI()
+	if err != nil {
+		return ids.Empty, err
+	}
+	ownerKey, registered, err := ln.getNodeOwnerKey(ctx, clientURI, nodeID)
+	if err != nil {
+		return ids.Empty, fmt.Errorf("node %q: %w", nodeName, err)
+	}
+
+	if !registered {
+		nodeOwner := ownerKey.PublicKey().Address()
+		nodeOwnerAddr, err := address.Format("P", constants.GetHRP(ln.networkID), nodeOwner.Bytes())
+		if err != nil {
+			return ids.Empty, err
+		}
+		states, err := getAddressStates(ctx, clientURI, nodeOwnerAddr)
+		if err != nil {
+			return ids.Empty, err
+		}
+		if states&txs.AddressStateConsortiumBit == 0 {
+			adminWallet, err := newWallet(ctx, clientURI, []*secp256k1.PrivateKey{ln.getAdminKey(pChainGenesisAdminKey)}, nil)
+			if err != nil {
+				return ids.Empty, err
+			}
+			cctx, cancel := createDefaultCtx(ctx)
+			txID, err := adminWallet.issueAddressStateTx(nodeOwner, txs.AddressStateConsortium, false, common.WithContext(cctx), defaultPoll)
+			cancel()
+			if err != nil {
+				return ids.Empty, fmt.Errorf("P-Wallet Tx Error %s %w, address %s", "IssueAddressStateTx", err, nodeOwnerAddr)
+			}
+			ln.log.Info("set node owner as consortium member", zap.String("node-name", nodeName), zap.String("address", nodeOwnerAddr), zap.String("tx-ID", txID.String()))
+		}
+
+		cctx, cancel := createDefaultCtx(ctx)
+		txID, err := w.issueRegisterNodeTx(nodeKey, ownerKey, common.WithContext(cctx), defaultPoll)
+		cancel()
+		if err != nil {
+			return ids.Empty, fmt.Errorf("P-Wallet Tx Error %s %w, node ID %s", "IssueRegisterNodeTx", err, nodeID.String())
+		}
+		ln.log.Info("registered node", zap.String("node-name", nodeName), zap.String("node-ID", nodeID.String()), zap.String("node-owner", nodeOwnerAddr), zap.String("tx-ID", txID.String()))
+	}
+
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	txID, err := w.issueCaminoAddValidatorTx(
+		nodeID,
+		ownerKey,
+		uint64(time.Now().Add(validationStartOffset).Unix()),
+		uint64(time.Now().Add(duration).Unix()),
+		amount,
+		rewardsOwner,
+		common.WithContext(cctx),
+	)
+	if err != nil {
+		return ids.Empty, fmt.Errorf("P-Wallet Tx Error %s %w, node ID %s", "IssueCaminoAddValidatorTx", err, nodeID.String())
+	}
+	return txID, nil
+}
+
+// Returns the key of the consortium member [nodeID] is registered to, and
+// true, if it is registered. Otherwise returns the first node owner key not
+// owning a node, to register it to.
+func (ln *localNetwork) getNodeOwnerKey(
+	ctx context.Context,
+	clientURI string,
+	nodeID ids.NodeID,
+) (*secp256k1.PrivateKey, bool, error) {
+	ownerKeys, err := ln.validatorBond.GetNodeOwnerKeys()
+	if err != nil {
+		return nil, false, err
+	}
+	if len(ownerKeys) == 0 {
+		ownerKeys = ln.getFundingKeys()
+	}
+	ownerAddr, registered, err := getRegisteredLink(ctx, clientURI, nodeID.String())
+	if err != nil {
+		return nil, false, err
+	}
+	if registered {
+		for _, key := range ownerKeys {
+			if key.PublicKey().Address() == ownerAddr {
+				return key, true, nil
+			}
+		}
+		addr, err := address.Format("P", constants.GetHRP(ln.networkID), ownerAddr.Bytes())
+		if err != nil {
+			return nil, false, err
+		}
+		return nil, false, fmt.Errorf("%w %s", errUnknownNodeOwner, addr)
+	}
+	for _, key := range ownerKeys {
+		addr, err := address.Format("P", constants.GetHRP(ln.networkID), key.PublicKey().Address().Bytes())
+		if err != nil {
+			return nil, false, err
+		}
+		_, ownsNode, err := getRegisteredLink(ctx, clientURI, addr)
+		if err != nil {
+			return nil, false, err
+		}
+		if !ownsNode {
+			return key, false, nil
+		}
+	}
+	return nil, false, ErrNoFreeNodeOwner
+}
+
+// Returns the short ID registered with [id]: the consortium member owning
+// the node if [id] is a node ID, or the node owned by the consortium member
+// if [id] is an address. Returns false if there is none.
+func getRegisteredLink(ctx context.Context, clientURI string, id string) (ids.ShortID, bool, error) {
+	// not exposed by the platformvm client
+	requester := rpc.NewEndpointRequester(clientURI + "/ext/P")
+	link := api.JSONAddress{}
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	err := requester.SendRequest(cctx, "platform.getRegisteredShortIDLink", &api.JSONAddress{Address: id}, &link)
+	switch {
+	case err == nil:
+	case strings.Contains(err.Error(), database.ErrNotFound.Error()):
+		return ids.ShortEmpty, false, nil
+	default:
+		return ids.ShortEmpty, false, err
+	}
+	if nodeID, err := ids.NodeIDFromString(link.Address); err == nil {
+		return ids.ShortID(nodeID), true, nil
+	}
+	linkID, err := parseAddress(link.Address)
+	if err != nil {
+		return ids.ShortEmpty, false, err
+	}
+	return linkID, true, nil
+}
+
+// Issues a RegisterNodeTx registering the node of [nodeKey] to the
+// consortium member of [ownerKey].
+func (w *wallet) issueRegisterNodeTx(
+	nodeKey *secp256k1.PrivateKey,
+	ownerKey *secp256k1.PrivateKey,
+	options ...common.Option,
+) (ids.ID, error) {
+	ctx := common.NewOptions(options).Context()
+	ins, outs, signers, err := w.lock(ctx, 0, w.pBackend.BaseTxFee(), locked.StateUnlocked)
+	if err != nil {
+		return ids.Empty, err
+	}
+	utx := &txs.RegisterNodeTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    w.pBackend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		NewNodeID:        ids.NodeID(nodeKey.PublicKey().Address()),
+		NodeOwnerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+		NodeOwnerAddress: ownerKey.PublicKey().Address(),
+	}
+	// the node signs as the new node, then the consortium member as its owner
+	signers = append(signers, []*secp256k1.PrivateKey{nodeKey}, []*secp256k1.PrivateKey{ownerKey})
+	return w.issueSignedTx(utx, signers, options...)
+}
+
+// Issues a CaminoAddValidatorTx bonding [amount] for [nodeID], signed by
+// [ownerKey] as the consortium member the node is registered to.
+func (w *wallet) issueCaminoAddValidatorTx(
+	nodeID ids.NodeID,
+	ownerKey *secp256k1.PrivateKey,
+	start uint64,
+	end uint64,
+	amount uint64,
+	rewardsOwner ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	ctx := common.NewOptions(options).Context()
+	ins, outs, signers, err := w.lock(ctx, amount, w.pBackend.AddPrimaryNetworkValidatorFee(), locked.StateBonded)
+	if err != nil {
+		return ids.Empty, err
+	}
+	utx := &txs.CaminoAddValidatorTx{
+		AddValidatorTx: txs.AddValidatorTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    w.pBackend.NetworkID(),
+				BlockchainID: constants.PlatformChainID,
+				Ins:          ins,
+				Outs:         outs,
+			}},
+			Validator: txs.Validator{
+				NodeID: nodeID,
+				Start:  start,
+				End:    end,
+				Wght:   amount,
+			},
+			RewardsOwner: &secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{rewardsOwner},
+			},
+		},
+		NodeOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+	}
+	signers = append(signers, []*secp256k1.PrivateKey{ownerKey})
+	return w.issueSignedTx(utx, signers, options...)
+}
+
+// Signs [utx] with [signers], one set per credential, and issues it.
+func (w *wallet) issueSignedTx(
+	utx txs.UnsignedTx,
+	signers [][]*secp256k1.PrivateKey,
+	options ...common.Option,
+) (ids.ID, error) {
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return ids.Empty, err
+	}
+	if err := tx.SyntacticVerify(w.snowContext()); err != nil {
+		return ids.Empty, err
+	}
+	return w.pWallet.IssueTx(tx, options...)
+}
+
+// Returns the inputs, outputs and signers spending the funds of [w] to lock
+// [amountToLock] with [lockState] and burn [amountToBurn].
+// The p wallet builder doesn't know of camino locks, so the camino utxo
+// handler does it.
+func (w *wallet) lock(
+	ctx context.Context,
+	amountToLock uint64,
+	amountToBurn uint64,
+	lockState locked.State,
+) ([]*avax.TransferableInput, []*avax.TransferableOutput, [][]*secp256k1.PrivateKey, error) {
+	utxos, err := w.pBackend.UTXOs(ctx, constants.PlatformChainID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	utxoDB := utxoReader{}
+	for _, u := range utxos {
+		utxoDB[u.InputID()] = u
+	}
+	handler := utxo.NewCaminoHandler(w.snowContext(), &mockable.Clock{}, &secp256k1fx.Fx{}, true)
+	ins, outs, signers, _, err := handler.Lock(
+		utxoDB,
+		w.keys,
+		amountToLock,
+		amountToBurn,
+		lockState,
+		nil,
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{w.addr},
+		},
+		0,
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return ins, outs, signers, nil
+}
+
+// Returns the context the camino utxo handler and tx verification need.
+func (w *wallet) snowContext() *snow.Context {
+	return &snow.Context{
+		NetworkID:   w.pBackend.NetworkID(),
+		ChainID:     constants.PlatformChainID,
+		AVAXAssetID: w.pBackend.AVAXAssetID(),
+		Log:         logging.NoLog{},
+	}
+}
+
+// avax.UTXOReader over the P-chain utxos of a wallet, by utxo ID
+type utxoReader map[ids.ID]*avax.UTXO
+
+func (r utxoReader) GetUTXO(utxoID ids.ID) (*avax.UTXO, error) {
+	u, ok := r[utxoID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return u, nil
+}
+
+// Returns the IDs of all the utxos owned by [addr]. Pagination is not
+// needed, as the utxo handler asks for all of them at once.
+func (r utxoReader) UTXOIDs(addr []byte, _ ids.ID, _ int) ([]ids.ID, error) {
+	utxoIDs := []ids.ID{}
+	for utxoID, u := range r {
+		out, ok := u.Out.(avax.Addressable)
+		if !ok {
+			continue
+		}
+		for _, owner := range out.Addresses() {
+			if bytes.Equal(owner, addr) {
+				utxoIDs = append(utxoIDs, utxoID)
+				break
+			}
+		}
+	}
+	return utxoIDs, nil
+}
@@ -0,0 +1,247 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/p"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+	"github.com/stretchr/testify/require"
+)
+
+const testTxFee = 1_000
+
+// p.Wallet recording the issued txs instead of issuing them
+type testPWallet struct {
+	p.Wallet
+	issued []*txs.Tx
+}
+
+func (w *testPWallet) IssueTx(tx *txs.Tx, _ ...common.Option) (ids.ID, error) {
+	w.issued = append(w.issued, tx)
+	return tx.ID(), nil
+}
+
+// Returns a wallet funded by [keys], with the P-chain [utxos], and the
+// p.Wallet recording the txs it issues.
+func newTestWallet(t *testing.T, keys []*secp256k1.PrivateKey, utxos ...*avax.UTXO) (*wallet, *testPWallet) {
+	pCTX := p.NewContext(
+		constants.UnitTestID,
+		ids.GenerateTestID(),
+		testTxFee,
+		testTxFee,
+		testTxFee,
+		testTxFee,
+		testTxFee,
+		testTxFee,
+		testTxFee,
+		testTxFee,
+	)
+	pUTXOs := primary.NewChainUTXOs(constants.PlatformChainID, primary.NewUTXOs())
+	for _, u := range utxos {
+		u.Asset = avax.Asset{ID: pCTX.AVAXAssetID()}
+		require.NoError(t, pUTXOs.AddUTXO(context.Background(), constants.PlatformChainID, u))
+	}
+	pWallet := &testPWallet{}
+	return &wallet{
+		addr:     keys[0].PublicKey().Address(),
+		key:      keys[0],
+		keys:     keys,
+		pBackend: p.NewBackend(pCTX, pUTXOs, nil),
+		pWallet:  pWallet,
+	}, pWallet
+}
+
+func newTestKey(t *testing.T) *secp256k1.PrivateKey {
+	key, err := (&secp256k1.Factory{}).NewPrivateKey()
+	require.NoError(t, err)
+	return key
+}
+
+// Returns a utxo of [amount] owned by [owner], locked by [lockIDs].
+func newTestUTXO(amount uint64, owner ids.ShortID, lockIDs locked.IDs) *avax.UTXO {
+	var out avax.TransferableOut = &secp256k1fx.TransferOutput{
+		Amt:          amount,
+		OutputOwners: secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{owner}},
+	}
+	if lockIDs.IsLocked() {
+		out = &locked.Out{IDs: lockIDs, TransferableOut: out}
+	}
+	return &avax.UTXO{UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()}, Out: out}
+}
+
+// Returns the addresses of the signers of each credential of [tx].
+func getCredSigners(t *testing.T, tx *txs.Tx) [][]ids.ShortID {
+	require := require.New(t)
+	factory := secp256k1.Factory{}
+	signers := make([][]ids.ShortID, len(tx.Creds))
+	for i, cred := range tx.Creds {
+		secpCred, ok := cred.(*secp256k1fx.Credential)
+		require.True(ok)
+		for _, sig := range secpCred.Sigs {
+			pubKey, err := factory.RecoverPublicKey(tx.Unsigned.Bytes(), sig[:])
+			require.NoError(err)
+			signers[i] = append(signers[i], pubKey.Address())
+		}
+	}
+	return signers
+}
+
+func TestUTXOReader(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	owner := ids.GenerateTestShortID()
+	other := ids.GenerateTestShortID()
+	newOut := func(addr ids.ShortID) *secp256k1fx.TransferOutput {
+		return &secp256k1fx.TransferOutput{
+			Amt:          1,
+			OutputOwners: secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr}},
+		}
+	}
+	unlocked := &avax.UTXO{UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()}, Out: newOut(owner)}
+	bonded := &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+		Out: &locked.Out{
+			IDs:             locked.IDs{BondTxID: ids.GenerateTestID()},
+			TransferableOut: newOut(owner),
+		},
+	}
+	notOwned := &avax.UTXO{UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()}, Out: newOut(other)}
+	r := utxoReader{}
+	for _, u := range []*avax.UTXO{unlocked, bonded, notOwned} {
+		r[u.InputID()] = u
+	}
+
+	utxoIDs, err := r.UTXOIDs(owner.Bytes(), ids.Empty, 10)
+	require.NoError(err)
+	require.ElementsMatch([]ids.ID{unlocked.InputID(), bonded.InputID()}, utxoIDs)
+
+	u, err := r.GetUTXO(bonded.InputID())
+	require.NoError(err)
+	require.Equal(bonded, u)
+
+	_, err = r.GetUTXO(ids.GenerateTestID())
+	require.ErrorIs(err, database.ErrNotFound)
+}
+
+func TestGetNodeValidation(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	stake, delegationFee, duration := getNodeValidation(&localNode{}, 2_000)
+	require.Equal(uint64(2_000), stake)
+	require.Equal(uint32(validatorDelegationFee), delegationFee)
+	require.Equal(validationDuration, duration)
+
+	fee := uint32(20_000)
+	configured := &localNode{config: node.Config{Validator: node.ValidatorConfig{
+		Stake:         5_000,
+		DelegationFee: &fee,
+		Duration:      30 * 24 * time.Hour,
+	}}}
+	stake, delegationFee, duration = getNodeValidation(configured, 2_000)
+	require.Equal(uint64(5_000), stake)
+	require.Equal(uint32(20_000), delegationFee)
+	require.Equal(30*24*time.Hour, duration)
+
+	// a zero fee is kept
+	noFee := uint32(0)
+	_, delegationFee, _ = getNodeValidation(&localNode{config: node.Config{Validator: node.ValidatorConfig{
+		DelegationFee: &noFee,
+	}}}, 2_000)
+	require.Zero(delegationFee)
+}
+
+func TestCheckValidationDuration(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	minStakeDuration := uint64((24 * time.Hour).Seconds())
+	require.NoError(checkValidationDuration("node1", validationStartOffset+24*time.Hour, minStakeDuration))
+	err := checkValidationDuration("node1", 24*time.Hour, minStakeDuration)
+	require.ErrorContains(err, `validation duration 24h0m0s of node "node1" is below the minimum`)
+}
+
+func TestIssueRegisterNodeTx(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	fundingKey, nodeKey, ownerKey := newTestKey(t), newTestKey(t), newTestKey(t)
+	fundingAddr := fundingKey.PublicKey().Address()
+	w, pWallet := newTestWallet(t, []*secp256k1.PrivateKey{fundingKey},
+		newTestUTXO(5*testTxFee, fundingAddr, locked.IDsEmpty),
+	)
+
+	_, err := w.issueRegisterNodeTx(nodeKey, ownerKey)
+	require.NoError(err)
+	require.Len(pWallet.issued, 1)
+	tx := pWallet.issued[0]
+	utx, ok := tx.Unsigned.(*txs.RegisterNodeTx)
+	require.True(ok)
+	require.Equal(ids.NodeID(nodeKey.PublicKey().Address()), utx.NewNodeID)
+	require.Equal(ownerKey.PublicKey().Address(), utx.NodeOwnerAddress)
+	// the fee is burned, and the rest goes back as change
+	require.Len(utx.Ins, 1)
+	require.Len(utx.Outs, 1)
+	require.Equal(uint64(4*testTxFee), utx.Outs[0].Out.Amount())
+
+	// input creds, then the new node, then its consortium member
+	require.Equal([][]ids.ShortID{
+		{fundingAddr},
+		{nodeKey.PublicKey().Address()},
+		{ownerKey.PublicKey().Address()},
+	}, getCredSigners(t, tx))
+}
+
+func TestIssueCaminoAddValidatorTx(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	fundingKey, ownerKey := newTestKey(t), newTestKey(t)
+	fundingAddr := fundingKey.PublicKey().Address()
+	w, pWallet := newTestWallet(t, []*secp256k1.PrivateKey{fundingKey},
+		newTestUTXO(10*testTxFee, fundingAddr, locked.IDsEmpty),
+	)
+	nodeID := ids.GenerateTestNodeID()
+
+	_, err := w.issueCaminoAddValidatorTx(nodeID, ownerKey, 100, 200, 5*testTxFee, fundingAddr)
+	require.NoError(err)
+	require.Len(pWallet.issued, 1)
+	tx := pWallet.issued[0]
+	utx, ok := tx.Unsigned.(*txs.CaminoAddValidatorTx)
+	require.True(ok)
+	require.Equal(nodeID, utx.NodeID())
+	require.Equal(uint64(5*testTxFee), utx.Validator.Wght)
+	bonded, unlocked := uint64(0), uint64(0)
+	for _, out := range utx.Outs {
+		if lockedOut, ok := out.Out.(*locked.Out); ok {
+			require.Equal(locked.ThisTxID, lockedOut.BondTxID)
+			bonded += out.Out.Amount()
+			continue
+		}
+		unlocked += out.Out.Amount()
+	}
+	require.Equal(uint64(5*testTxFee), bonded)
+	require.Equal(uint64(4*testTxFee), unlocked)
+
+	// input creds, then the consortium member owning the node
+	require.Equal([][]ids.ShortID{
+		{fundingAddr},
+		{ownerKey.PublicKey().Address()},
+	}, getCredSigners(t, tx))
+}
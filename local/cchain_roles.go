@@ -0,0 +1,261 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/api"
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	admin "github.com/ava-labs/coreth/contracts/build_contracts/admin/src"
+	"github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"go.uber.org/zap"
+)
+
+const (
+	// KYC state of the admin contract allowing to deploy contracts
+	kycApprovedState = 1 << 0
+	// C-chain role allowing to apply KYC states
+	kycRole = 1 << 2
+)
+
+var (
+	// proxy of the camino C-chain admin contract, predeployed by the genesis
+	adminContractAddr = common.HexToAddress("0x010000000000000000000000000000000000000a")
+
+	errTxReverted = errors.New("C-chain tx reverted")
+)
+
+// See network.Network
+func (ln *localNetwork) SetCChainRole(ctx context.Context, addr string, role uint64, revoke bool) (common.Hash, error) {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if ln.stopCalled() {
+		return common.Hash{}, network.ErrStopped
+	}
+	ethAddr, err := parseEthAddress(addr)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	node := ln.getSomeNode()
+	if node == nil {
+		return common.Hash{}, errNoRunningNode
+	}
+	method := "grantRole"
+	if revoke {
+		method = "revokeRole"
+	}
+	cli := node.GetAPIClient().CChainEthAPI()
+	txHash, err := issueAdminTx(ctx, cli, ln.getAdminKey(cChainGenesisAdminKey), method, ethAddr, new(big.Int).SetUint64(role))
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("C-chain Tx Error %s %w, address %s", method, err, addr)
+	}
+	ln.log.Info("set C-chain role",
+		zap.String("address", addr),
+		zap.Uint64("role", role),
+		zap.Bool("revoke", revoke),
+		zap.String("tx-hash", txHash.Hex()),
+	)
+	return txHash, nil
+}
+
+// See network.Network
+func (ln *localNetwork) SetCChainDeployer(ctx context.Context, addr string, allowed bool) (common.Hash, error) {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if ln.stopCalled() {
+		return common.Hash{}, network.ErrStopped
+	}
+	ethAddr, err := parseEthAddress(addr)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	node := ln.getSomeNode()
+	if node == nil {
+		return common.Hash{}, errNoRunningNode
+	}
+	cli := node.GetAPIClient().CChainEthAPI()
+	adminKey := ln.getAdminKey(cChainGenesisAdminKey)
+	adminAddr := crypto.PubkeyToAddress(adminKey.ToECDSA().PublicKey)
+	adminRoles, err := callAdminContract(ctx, cli, "getRoles", adminAddr)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	// KYC states can only be applied by holders of the KYC role,
+	// which the admin grants to itself if needed
+	if adminRoles.Uint64()&kycRole == 0 {
+		if _, err := issueAdminTx(ctx, cli, adminKey, "grantRole", adminAddr, big.NewInt(kycRole)); err != nil {
+			return common.Hash{}, fmt.Errorf("C-chain Tx Error %s %w, address %s", "grantRole", err, adminAddr.Hex())
+		}
+	}
+	txHash, err := issueAdminTx(ctx, cli, adminKey, "applyKycState", ethAddr, !allowed, big.NewInt(kycApprovedState))
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("C-chain Tx Error %s %w, address %s", "applyKycState", err, addr)
+	}
+	ln.log.Info("set C-chain deployer",
+		zap.String("address", addr),
+		zap.Bool("allowed", allowed),
+		zap.String("tx-hash", txHash.Hex()),
+	)
+	return txHash, nil
+}
+
+// See network.Network
+func (ln *localNetwork) GetCChainRoles(ctx context.Context, addr string) (uint64, bool, error) {
+	ln.lock.RLock()
+	defer ln.lock.RUnlock()
+
+	if ln.stopCalled() {
+		return 0, false, network.ErrStopped
+	}
+	ethAddr, err := parseEthAddress(addr)
+	if err != nil {
+		return 0, false, err
+	}
+	node := ln.getSomeNode()
+	if node == nil {
+		return 0, false, errNoRunningNode
+	}
+	cli := node.GetAPIClient().CChainEthAPI()
+	roles, err := callAdminContract(ctx, cli, "getRoles", ethAddr)
+	if err != nil {
+		return 0, false, err
+	}
+	kycState, err := callAdminContract(ctx, cli, "getKycState", ethAddr)
+	if err != nil {
+		return 0, false, err
+	}
+	return roles.Uint64(), kycState.Uint64()&kycApprovedState != 0, nil
+}
+
+// Calls the view [method] of the admin contract, which returns an uint256.
+func callAdminContract(ctx context.Context, cli api.EthClient, method string, args ...interface{}) (*big.Int, error) {
+	adminABI, err := admin.BuildMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	data, err := adminABI.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	out, err := cli.CallContract(cctx, interfaces.CallMsg{To: &adminContractAddr, Data: data}, nil)
+	if err != nil {
+		return nil, err
+	}
+	values, err := adminABI.Unpack(method, out)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := values[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected %s result type %T", method, values[0])
+	}
+	return value, nil
+}
+
+// Issues a tx signed by [key] calling [method] of the admin contract,
+// and waits for it to be accepted.
+// Returns the hash of the tx.
+func issueAdminTx(
+	ctx context.Context,
+	cli api.EthClient,
+	key *secp256k1.PrivateKey,
+	method string,
+	args ...interface{},
+) (common.Hash, error) {
+	adminABI, err := admin.BuildMetaData.GetAbi()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	data, err := adminABI.Pack(method, args...)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	ecdsaKey := key.ToECDSA()
+	from := crypto.PubkeyToAddress(ecdsaKey.PublicKey)
+	chainID, err := cli.ChainID(cctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	nonce, err := cli.NonceAt(cctx, from, nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	gasPrice, err := cli.SuggestGasPrice(cctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	// fails if the contract reverts, e.g. if [key] lacks the needed role
+	gas, err := cli.EstimateGas(cctx, interfaces.CallMsg{From: from, To: &adminContractAddr, Data: data})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	tx, err := types.SignTx(
+		types.NewTx(&types.LegacyTx{
+			Nonce:    nonce,
+			GasPrice: gasPrice,
+			Gas:      gas,
+			To:       &adminContractAddr,
+			Data:     data,
+		}),
+		types.LatestSignerForChainID(chainID),
+		ecdsaKey,
+	)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err := cli.SendTransaction(cctx, tx); err != nil {
+		return common.Hash{}, err
+	}
+	receipt, err := waitForReceipt(cctx, cli, tx.Hash())
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return common.Hash{}, fmt.Errorf("%w: %s", errTxReverted, tx.Hash().Hex())
+	}
+	return tx.Hash(), nil
+}
+
+// Polls the receipt of the tx [txHash] until it is found or [ctx] is done.
+func waitForReceipt(ctx context.Context, cli api.EthClient, txHash common.Hash) (*types.Receipt, error) {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		receipt, err := cli.TransactionReceipt(ctx, txHash)
+		switch {
+		case err == nil:
+			return receipt, nil
+		case !errors.Is(err, interfaces.NotFound):
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Returns the C-chain address [addr], given in hex.
+func parseEthAddress(addr string) (common.Address, error) {
+	if !common.IsHexAddress(addr) {
+		return common.Address{}, fmt.Errorf("invalid C-chain address %q", addr)
+	}
+	return common.HexToAddress(addr), nil
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/api/mocks"
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const genesisAdminEthAddr = "0x8db97C7cEcE249c2b98bDC0226Cc4C2A57BF52FC"
+
+func TestCChainRoleErrors(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	ln := &localNetwork{
+		log:   logging.NoLog{},
+		nodes: map[string]*localNode{"node1": {paused: true}},
+	}
+	ctx := context.Background()
+	_, err := ln.SetCChainRole(ctx, "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3", 1, false)
+	require.ErrorContains(err, "invalid C-chain address")
+	_, err = ln.SetCChainRole(ctx, genesisAdminEthAddr, 1, false)
+	require.ErrorIs(err, errNoRunningNode)
+	_, err = ln.SetCChainDeployer(ctx, genesisAdminEthAddr, true)
+	require.ErrorIs(err, errNoRunningNode)
+	_, _, err = ln.GetCChainRoles(ctx, genesisAdminEthAddr)
+	require.ErrorIs(err, errNoRunningNode)
+}
+
+func TestIssueAdminTx(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	addr := common.HexToAddress(genesisAdminEthAddr)
+	cli := &mocks.EthClient{}
+	cli.On("ChainID", mock.Anything).Return(big.NewInt(503), nil)
+	cli.On("NonceAt", mock.Anything, addr, mock.Anything).Return(uint64(3), nil)
+	cli.On("SuggestGasPrice", mock.Anything).Return(big.NewInt(1), nil)
+	cli.On("EstimateGas", mock.Anything, mock.MatchedBy(func(msg interfaces.CallMsg) bool {
+		return msg.From == addr && *msg.To == adminContractAddr
+	})).Return(uint64(50_000), nil)
+	var sent *types.Transaction
+	cli.On("SendTransaction", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		sent = args.Get(1).(*types.Transaction)
+	}).Return(nil)
+	// not accepted yet on the first poll
+	cli.On("TransactionReceipt", mock.Anything, mock.Anything).Return(nil, interfaces.NotFound).Once()
+	cli.On("TransactionReceipt", mock.Anything, mock.Anything).Return(&types.Receipt{Status: types.ReceiptStatusSuccessful}, nil).Once()
+	cli.On("TransactionReceipt", mock.Anything, mock.Anything).Return(&types.Receipt{Status: types.ReceiptStatusFailed}, nil)
+
+	ctx := context.Background()
+	txHash, err := issueAdminTx(ctx, cli, genesis.EWOQKey, "grantRole", addr, big.NewInt(4))
+	require.NoError(err)
+	require.Equal(sent.Hash(), txHash)
+	require.Equal(uint64(3), sent.Nonce())
+	require.Equal(adminContractAddr, *sent.To())
+	sender, err := types.Sender(types.LatestSignerForChainID(big.NewInt(503)), sent)
+	require.NoError(err)
+	require.Equal(addr, sender)
+
+	_, err = issueAdminTx(ctx, cli, genesis.EWOQKey, "revokeRole", addr, big.NewInt(4))
+	require.ErrorIs(err, errTxReverted)
+	_, err = issueAdminTx(ctx, cli, genesis.EWOQKey, "mint", addr)
+	require.Error(err)
+}
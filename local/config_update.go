@@ -0,0 +1,207 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrChainNotFound  = errors.New("blockchain not found")
+	ErrSubnetNotFound = errors.New("subnet not found")
+	ErrEmptyConfig    = errors.New("empty config")
+)
+
+// UpdateChainConfig sets [chainConfig] as the config of the custom blockchain
+// [chainID] on the nodes tracking its subnet, or the entry of
+// [perNodeChainConfig] for the node if any.
+// Chain configs are only read by a node when it creates the chain, and no
+// API reloads them, so the running nodes whose config changed are restarted,
+// keeping their databases. Paused nodes get the config when resumed.
+// Returns the names of the restarted nodes.
+func (ln *localNetwork) UpdateChainConfig(
+	ctx context.Context,
+	chainID ids.ID,
+	chainConfig []byte,
+	perNodeChainConfig map[string][]byte,
+) ([]string, error) {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if ln.stopCalled() {
+		return nil, network.ErrStopped
+	}
+	if len(chainConfig) == 0 && len(perNodeChainConfig) == 0 {
+		return nil, ErrEmptyConfig
+	}
+	for nodeName := range perNodeChainConfig {
+		if _, ok := ln.nodes[nodeName]; !ok {
+			return nil, fmt.Errorf("node %q not found", nodeName)
+		}
+	}
+	subnetID, err := ln.getChainSubnetID(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	nodeNames, err := ln.getTrackingNodeNames(subnetID)
+	if err != nil {
+		return nil, err
+	}
+	chainAlias := chainID.String()
+	changed := []string{}
+	for _, nodeName := range nodeNames {
+		nodeChainConfig := chainConfig
+		if cfg, ok := perNodeChainConfig[nodeName]; ok {
+			nodeChainConfig = cfg
+		}
+		if len(nodeChainConfig) == 0 {
+			continue
+		}
+		if current, ok := ln.nodes[nodeName].config.ChainConfigFiles[chainAlias]; ok && current == string(nodeChainConfig) {
+			continue
+		}
+		ln.nodes[nodeName].config.ChainConfigFiles[chainAlias] = string(nodeChainConfig)
+		changed = append(changed, nodeName)
+	}
+	ln.log.Info(logging.Green.Wrap("updated chain config"),
+		zap.Stringer("blockchain-ID", chainID),
+		zap.Strings("node-names", changed),
+	)
+	return ln.restartForConfigUpdate(ctx, changed, []ids.ID{chainID})
+}
+
+// UpdateSubnetConfig sets [subnetConfig] as the config of [subnetID] on the
+// nodes tracking it.
+// Subnet configs are only read by a node on start, so the running nodes whose
+// config changed are restarted, keeping their databases. Paused nodes get the
+// config when resumed.
+// Returns the names of the restarted nodes.
+func (ln *localNetwork) UpdateSubnetConfig(
+	ctx context.Context,
+	subnetID ids.ID,
+	subnetConfig []byte,
+) ([]string, error) {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if ln.stopCalled() {
+		return nil, network.ErrStopped
+	}
+	if len(subnetConfig) == 0 {
+		return nil, ErrEmptyConfig
+	}
+	if subnetID == constants.PrimaryNetworkID {
+		return nil, fmt.Errorf("%w: %s is the primary network", ErrSubnetNotFound, subnetID)
+	}
+	someNode := ln.getSomeNode()
+	if someNode == nil {
+		return nil, errNoRunningNode
+	}
+	cctx, cancel := createDefaultCtx(ctx)
+	blockchains, err := someNode.GetAPIClient().PChainAPI().GetBlockchains(cctx)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+	chainIDs := []ids.ID{}
+	for _, blockchain := range blockchains {
+		if blockchain.SubnetID == subnetID {
+			chainIDs = append(chainIDs, blockchain.ID)
+		}
+	}
+	nodeNames, err := ln.getTrackingNodeNames(subnetID)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodeNames) == 0 {
+		return nil, fmt.Errorf("%w: no node tracks %s", ErrSubnetNotFound, subnetID)
+	}
+	changed := []string{}
+	for _, nodeName := range nodeNames {
+		if current, ok := ln.nodes[nodeName].config.SubnetConfigFiles[subnetID.String()]; ok && current == string(subnetConfig) {
+			continue
+		}
+		ln.nodes[nodeName].config.SubnetConfigFiles[subnetID.String()] = string(subnetConfig)
+		changed = append(changed, nodeName)
+	}
+	ln.log.Info(logging.Green.Wrap("updated subnet config"),
+		zap.Stringer("subnet-ID", subnetID),
+		zap.Strings("node-names", changed),
+	)
+	return ln.restartForConfigUpdate(ctx, changed, chainIDs)
+}
+
+// Returns the subnet of the custom blockchain [chainID].
+func (ln *localNetwork) getChainSubnetID(ctx context.Context, chainID ids.ID) (ids.ID, error) {
+	someNode := ln.getSomeNode()
+	if someNode == nil {
+		return ids.Empty, errNoRunningNode
+	}
+	cctx, cancel := createDefaultCtx(ctx)
+	blockchains, err := someNode.GetAPIClient().PChainAPI().GetBlockchains(cctx)
+	cancel()
+	if err != nil {
+		return ids.Empty, err
+	}
+	for _, blockchain := range blockchains {
+		if blockchain.ID == chainID && blockchain.SubnetID != constants.PrimaryNetworkID {
+			return blockchain.SubnetID, nil
+		}
+	}
+	return ids.Empty, fmt.Errorf("%w: %s", ErrChainNotFound, chainID)
+}
+
+// Returns the sorted names of the nodes tracking [subnetID].
+func (ln *localNetwork) getTrackingNodeNames(subnetID ids.ID) ([]string, error) {
+	nodeNames := []string{}
+	for nodeName, node := range ln.nodes {
+		trackSubnets, err := node.GetFlag(config.TrackSubnetsKey)
+		if err != nil {
+			return nil, err
+		}
+		if tracksAnySubnet(trackSubnets, set.Set[ids.ID]{subnetID: struct{}{}}) {
+			nodeNames = append(nodeNames, nodeName)
+		}
+	}
+	sort.Strings(nodeNames)
+	return nodeNames, nil
+}
+
+// Restarts the running nodes among [nodeNames] to load their updated configs,
+// and waits for them to bootstrap [chainIDs].
+// Returns the names of the restarted nodes.
+func (ln *localNetwork) restartForConfigUpdate(ctx context.Context, nodeNames []string, chainIDs []ids.ID) ([]string, error) {
+	restarted := []string{}
+	for _, nodeName := range nodeNames {
+		if ln.nodes[nodeName].paused {
+			continue
+		}
+		ln.log.Info(logging.Green.Wrap(fmt.Sprintf("restarting node %s to load the updated config", nodeName)))
+		if err := ln.restartNode(ctx, nodeName, "", "", "", nil, nil, nil); err != nil {
+			return nil, err
+		}
+		restarted = append(restarted, nodeName)
+	}
+	if len(restarted) == 0 {
+		return restarted, nil
+	}
+	if err := ln.healthy(ctx); err != nil {
+		return nil, err
+	}
+	if err := ln.waitChainsBootstrapped(ctx, restarted, chainIDs); err != nil {
+		return nil, err
+	}
+	return restarted, nil
+}
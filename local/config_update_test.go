@@ -0,0 +1,57 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTrackingNodeNames(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	subnetID1, subnetID2 := ids.GenerateTestID(), ids.GenerateTestID()
+	ln := &localNetwork{
+		log: logging.NoLog{},
+		nodes: map[string]*localNode{
+			"node1": {config: node.Config{Flags: map[string]interface{}{config.TrackSubnetsKey: subnetID2.String() + "," + subnetID1.String()}}},
+			"node2": {config: node.Config{Flags: map[string]interface{}{config.TrackSubnetsKey: subnetID2.String()}}},
+			"node0": {config: node.Config{Flags: map[string]interface{}{config.TrackSubnetsKey: subnetID1.String()}}},
+			"node3": {},
+		},
+	}
+	nodeNames, err := ln.getTrackingNodeNames(subnetID1)
+	require.NoError(err)
+	require.Equal([]string{"node0", "node1"}, nodeNames)
+	nodeNames, err = ln.getTrackingNodeNames(ids.GenerateTestID())
+	require.NoError(err)
+	require.Empty(nodeNames)
+}
+
+func TestUpdateConfigErrors(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	ln := &localNetwork{
+		log:   logging.NoLog{},
+		nodes: map[string]*localNode{"node1": {}},
+	}
+	ctx := context.Background()
+	_, err := ln.UpdateChainConfig(ctx, ids.GenerateTestID(), nil, nil)
+	require.ErrorIs(err, ErrEmptyConfig)
+	_, err = ln.UpdateChainConfig(ctx, ids.GenerateTestID(), nil, map[string][]byte{"node2": []byte("{}")})
+	require.ErrorContains(err, "node2")
+	_, err = ln.UpdateSubnetConfig(ctx, ids.GenerateTestID(), nil)
+	require.ErrorIs(err, ErrEmptyConfig)
+	_, err = ln.UpdateSubnetConfig(ctx, constants.PrimaryNetworkID, []byte("{}"))
+	require.ErrorIs(err, ErrSubnetNotFound)
+}
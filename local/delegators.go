@@ -0,0 +1,157 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrDelegationNotSupported = errors.New("delegation is not supported on networks locking stakes as bonds")
+	ErrNotValidator           = errors.New("node is not a current validator")
+)
+
+// See network.Network
+func (ln *localNetwork) AddDelegator(ctx context.Context, nodeName string, spec network.DelegatorSpec) (ids.ID, error) {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if ln.stopCalled() {
+		return ids.Empty, network.ErrStopped
+	}
+	node, ok := ln.nodes[nodeName]
+	if !ok {
+		return ids.Empty, fmt.Errorf("%w: %q", network.ErrNodeNotFound, nodeName)
+	}
+	if ln.getSomeNode() == nil {
+		return ids.Empty, errNoRunningNode
+	}
+	clientURI, err := ln.getClientURI()
+	if err != nil {
+		return ids.Empty, err
+	}
+	platformCli := platformvm.NewClient(clientURI)
+
+	cctx, cancel := createDefaultCtx(ctx)
+	caminoConfig, err := platformCli.GetConfiguration(cctx)
+	cancel()
+	if err != nil {
+		return ids.Empty, err
+	}
+	// camino rejects delegator txs when stakes are locked as bonds
+	if caminoConfig.LockModeBondDeposit {
+		return ids.Empty, ErrDelegationNotSupported
+	}
+
+	nodeID := node.GetNodeID()
+	cctx, cancel = createDefaultCtx(ctx)
+	vdrs, err := platformCli.GetCurrentValidators(cctx, spec.SubnetID, []ids.NodeID{nodeID})
+	cancel()
+	if err != nil {
+		return ids.Empty, err
+	}
+	if len(vdrs) == 0 {
+		return ids.Empty, fmt.Errorf("%w: %q of subnet %s", ErrNotValidator, nodeName, spec.SubnetID)
+	}
+	start := time.Now().Add(validationStartOffset)
+	end, err := getDelegationEnd(start, spec.Duration, time.Unix(int64(vdrs[0].EndTime), 0))
+	if err != nil {
+		return ids.Empty, err
+	}
+
+	w, err := newWallet(ctx, clientURI, ln.getFundingKeys(), nil)
+	if err != nil {
+		return ids.Empty, err
+	}
+	amount := spec.Amount
+	if amount == 0 {
+		cctx, cancel = createDefaultCtx(ctx)
+		_, minDelegatorStake, err := platformCli.GetMinStake(cctx, spec.SubnetID)
+		cancel()
+		if err != nil {
+			return ids.Empty, err
+		}
+		amount = minDelegatorStake
+	}
+	rewardsOwner := w.addr
+	if spec.RewardsOwner != "" {
+		rewardsOwner, err = parseAddress(spec.RewardsOwner)
+		if err != nil {
+			return ids.Empty, err
+		}
+	}
+	assetID := w.pWallet.AVAXAssetID()
+	needed := amount + w.pBackend.AddPrimaryNetworkDelegatorFee()
+	if spec.SubnetID != constants.PrimaryNetworkID {
+		// elastic subnets are delegated with their own staking asset
+		cctx, cancel = createDefaultCtx(ctx)
+		assetID, err = platformCli.GetStakingAssetID(cctx, spec.SubnetID)
+		cancel()
+		if err != nil {
+			return ids.Empty, err
+		}
+		needed = w.pBackend.AddSubnetDelegatorFee()
+	}
+	if err := w.checkBalance(ctx, needed, "the delegation"); err != nil {
+		return ids.Empty, err
+	}
+
+	cctx, cancel = createDefaultCtx(ctx)
+	defer cancel()
+	txID, err := w.pWallet.IssueAddPermissionlessDelegatorTx(
+		&txs.SubnetValidator{
+			Validator: txs.Validator{
+				NodeID: nodeID,
+				Start:  uint64(start.Unix()),
+				End:    uint64(end.Unix()),
+				Wght:   amount,
+			},
+			Subnet: spec.SubnetID,
+		},
+		assetID,
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{rewardsOwner},
+		},
+		common.WithContext(cctx),
+		defaultPoll,
+	)
+	if err != nil {
+		return ids.Empty, fmt.Errorf("P-Wallet Tx Error %s %w, node ID %s", "IssueAddPermissionlessDelegatorTx", err, nodeID)
+	}
+	ln.log.Info("added delegator",
+		zap.String("node-name", nodeName),
+		zap.Stringer("subnet-ID", spec.SubnetID),
+		zap.Uint64("amount", amount),
+		zap.Time("end", end),
+		zap.Stringer("tx-ID", txID),
+	)
+	return txID, nil
+}
+
+// Returns the end of a delegation starting at [start] and lasting [duration],
+// or until [validationEnd] if [duration] is 0.
+// Errors if the delegation wouldn't end within the validation.
+func getDelegationEnd(start time.Time, duration time.Duration, validationEnd time.Time) (time.Time, error) {
+	if duration == 0 {
+		return validationEnd, nil
+	}
+	end := start.Add(duration)
+	if end.After(validationEnd) {
+		return time.Time{}, fmt.Errorf("delegation end %s is after the validation end %s", end.Format(time.RFC3339), validationEnd.Format(time.RFC3339))
+	}
+	return end, nil
+}
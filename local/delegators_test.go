@@ -0,0 +1,48 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddDelegatorErrors(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	ln := &localNetwork{
+		log:   logging.NoLog{},
+		nodes: map[string]*localNode{"node1": {paused: true}},
+	}
+	ctx := context.Background()
+	_, err := ln.AddDelegator(ctx, "node2", network.DelegatorSpec{})
+	require.ErrorIs(err, network.ErrNodeNotFound)
+	_, err = ln.AddDelegator(ctx, "node1", network.DelegatorSpec{})
+	require.ErrorIs(err, errNoRunningNode)
+}
+
+func TestGetDelegationEnd(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	start := time.Unix(1_000_000, 0)
+	validationEnd := start.Add(48 * time.Hour)
+
+	end, err := getDelegationEnd(start, 0, validationEnd)
+	require.NoError(err)
+	require.Equal(validationEnd, end)
+
+	end, err = getDelegationEnd(start, 24*time.Hour, validationEnd)
+	require.NoError(err)
+	require.Equal(start.Add(24*time.Hour), end)
+
+	_, err = getDelegationEnd(start, 72*time.Hour, validationEnd)
+	require.ErrorContains(err, "after the validation end")
+}
@@ -0,0 +1,532 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	platformapi "github.com/ava-labs/avalanchego/vms/platformvm/api"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+	"go.uber.org/zap"
+)
+
+// See network.Network
+func (ln *localNetwork) GetDepositOffers(ctx context.Context) ([]*deposit.Offer, error) {
+	ln.lock.RLock()
+	defer ln.lock.RUnlock()
+
+	if ln.stopCalled() {
+		return nil, network.ErrStopped
+	}
+	if ln.getSomeNode() == nil {
+		return nil, errNoRunningNode
+	}
+	clientURI, err := ln.getClientURI()
+	if err != nil {
+		return nil, err
+	}
+	timestamp, err := getChainTimestamp(ctx, clientURI)
+	if err != nil {
+		return nil, err
+	}
+	return getDepositOffers(ctx, clientURI, timestamp)
+}
+
+// See network.Network
+func (ln *localNetwork) Deposit(ctx context.Context, offerID ids.ID, amount uint64, duration uint32) (ids.ID, error) {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if ln.stopCalled() {
+		return ids.Empty, network.ErrStopped
+	}
+	if ln.getSomeNode() == nil {
+		return ids.Empty, errNoRunningNode
+	}
+	clientURI, err := ln.getClientURI()
+	if err != nil {
+		return ids.Empty, err
+	}
+	w, err := newWallet(ctx, clientURI, ln.getFundingKeys(), nil)
+	if err != nil {
+		return ids.Empty, err
+	}
+	if err := w.checkBalance(ctx, amount+w.pBackend.BaseTxFee(), "the deposit"); err != nil {
+		return ids.Empty, err
+	}
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	txID, err := w.issueDepositTx(offerID, amount, duration, common.WithContext(cctx), defaultPoll)
+	if err != nil {
+		return ids.Empty, fmt.Errorf("P-Wallet Tx Error %s %w, deposit offer ID %s", "IssueDepositTx", err, offerID)
+	}
+	ln.log.Info("deposited",
+		zap.Stringer("deposit-offer-ID", offerID),
+		zap.Uint64("amount", amount),
+		zap.Uint32("duration", duration),
+		zap.Stringer("tx-ID", txID),
+	)
+	return txID, nil
+}
+
+// See network.Network
+func (ln *localNetwork) ClaimRewards(ctx context.Context, depositTxIDs []ids.ID) (ids.ID, uint64, error) {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if ln.stopCalled() {
+		return ids.Empty, 0, network.ErrStopped
+	}
+	if ln.getSomeNode() == nil {
+		return ids.Empty, 0, errNoRunningNode
+	}
+	clientURI, err := ln.getClientURI()
+	if err != nil {
+		return ids.Empty, 0, err
+	}
+	w, err := newWallet(ctx, clientURI, ln.getFundingKeys(), nil)
+	if err != nil {
+		return ids.Empty, 0, err
+	}
+	// amounts are computed at the chain time, which the claim tx time can't precede
+	timestamp, err := getChainTimestamp(ctx, clientURI)
+	if err != nil {
+		return ids.Empty, 0, err
+	}
+
+	claimables := []txs.ClaimAmount{}
+	total := uint64(0)
+	deposits, err := getDeposits(ctx, clientURI, depositTxIDs)
+	if err != nil {
+		return ids.Empty, 0, err
+	}
+	for i, d := range deposits {
+		if err := w.checkRewardOwner(d.RewardOwner); err != nil {
+			return ids.Empty, 0, fmt.Errorf("deposit %s: %w", depositTxIDs[i], err)
+		}
+		offer, err := getDepositOffer(ctx, clientURI, d.DepositOfferID, uint64(d.Start))
+		if err != nil {
+			return ids.Empty, 0, err
+		}
+		reward := d.ClaimableReward(offer, timestamp)
+		if reward == 0 {
+			continue
+		}
+		claimables = append(claimables, txs.ClaimAmount{
+			ID:     depositTxIDs[i],
+			Type:   txs.ClaimTypeActiveDepositReward,
+			Amount: reward,
+		})
+		if total, err = math.Add64(total, reward); err != nil {
+			return ids.Empty, 0, err
+		}
+	}
+
+	owner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{w.addr},
+	}
+	treasuryReward, err := getClaimableTreasuryReward(ctx, clientURI, ln.networkID, w.addr)
+	if err != nil {
+		return ids.Empty, 0, err
+	}
+	if treasuryReward > 0 {
+		ownerID, err := txs.GetOwnerID(owner)
+		if err != nil {
+			return ids.Empty, 0, err
+		}
+		claimables = append(claimables, txs.ClaimAmount{
+			ID:     ownerID,
+			Type:   txs.ClaimTypeAllTreasury,
+			Amount: treasuryReward,
+		})
+		if total, err = math.Add64(total, treasuryReward); err != nil {
+			return ids.Empty, 0, err
+		}
+	}
+	if len(claimables) == 0 {
+		return ids.Empty, 0, network.ErrNothingToClaim
+	}
+
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	txID, err := w.issueClaimTx(claimables, owner, common.WithContext(cctx), defaultPoll)
+	if err != nil {
+		return ids.Empty, 0, fmt.Errorf("P-Wallet Tx Error %s %w", "IssueClaimTx", err)
+	}
+	ln.log.Info("claimed rewards", zap.Uint64("amount", total), zap.Stringer("tx-ID", txID))
+	return txID, total, nil
+}
+
+// See network.Network
+func (ln *localNetwork) UnlockDeposit(ctx context.Context, depositTxIDs []ids.ID) (ids.ID, uint64, error) {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if ln.stopCalled() {
+		return ids.Empty, 0, network.ErrStopped
+	}
+	if ln.getSomeNode() == nil {
+		return ids.Empty, 0, errNoRunningNode
+	}
+	clientURI, err := ln.getClientURI()
+	if err != nil {
+		return ids.Empty, 0, err
+	}
+	w, err := newWallet(ctx, clientURI, ln.getFundingKeys(), nil)
+	if err != nil {
+		return ids.Empty, 0, err
+	}
+	timestamp, err := getChainTimestamp(ctx, clientURI)
+	if err != nil {
+		return ids.Empty, 0, err
+	}
+
+	deposits, err := getDeposits(ctx, clientURI, depositTxIDs)
+	if err != nil {
+		return ids.Empty, 0, err
+	}
+	unlockableAmounts := map[ids.ID]uint64{}
+	for i, d := range deposits {
+		offer, err := getDepositOffer(ctx, clientURI, d.DepositOfferID, uint64(d.Start))
+		if err != nil {
+			return ids.Empty, 0, err
+		}
+		if amount := d.UnlockableAmount(offer, timestamp); amount > 0 {
+			unlockableAmounts[depositTxIDs[i]] = amount
+		}
+	}
+	if len(unlockableAmounts) == 0 {
+		return ids.Empty, 0, network.ErrNothingToUnlock
+	}
+
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	txID, unlocked, err := w.issueUnlockDepositTx(unlockableAmounts, common.WithContext(cctx), defaultPoll)
+	if err != nil {
+		return ids.Empty, 0, fmt.Errorf("P-Wallet Tx Error %s %w", "IssueUnlockDepositTx", err)
+	}
+	if unlocked == 0 {
+		return ids.Empty, 0, network.ErrNothingToUnlock
+	}
+	ln.log.Info("unlocked deposits", zap.Uint64("amount", unlocked), zap.Stringer("tx-ID", txID))
+	return txID, unlocked, nil
+}
+
+// Returns the P-chain time of the node at [clientURI], as unix time.
+func getChainTimestamp(ctx context.Context, clientURI string) (uint64, error) {
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	timestamp, err := platformvm.NewClient(clientURI).GetTimestamp(cctx)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(timestamp.Unix()), nil
+}
+
+// Returns the deposit offers active at unix time [timestamp].
+func getDepositOffers(ctx context.Context, clientURI string, timestamp uint64) ([]*deposit.Offer, error) {
+	// not exposed by the platformvm client
+	requester := rpc.NewEndpointRequester(clientURI + "/ext/P")
+	reply := platformvm.GetAllDepositOffersReply{}
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	if err := requester.SendRequest(cctx, "platform.getAllDepositOffers", &platformvm.GetAllDepositOffersArgs{Timestamp: json.Uint64(timestamp)}, &reply); err != nil {
+		return nil, err
+	}
+	offers := make([]*deposit.Offer, len(reply.DepositOffers))
+	for i, o := range reply.DepositOffers {
+		offers[i] = &deposit.Offer{
+			ID:                      o.ID,
+			InterestRateNominator:   uint64(o.InterestRateNominator),
+			Start:                   uint64(o.Start),
+			End:                     uint64(o.End),
+			MinAmount:               uint64(o.MinAmount),
+			TotalMaxAmount:          uint64(o.TotalMaxAmount),
+			DepositedAmount:         uint64(o.DepositedAmount),
+			MinDuration:             o.MinDuration,
+			MaxDuration:             o.MaxDuration,
+			UnlockPeriodDuration:    o.UnlockPeriodDuration,
+			NoRewardsPeriodDuration: o.NoRewardsPeriodDuration,
+			Memo:                    o.Memo,
+			Flags:                   uint64(o.Flags),
+		}
+	}
+	return offers, nil
+}
+
+// Returns the deposit offer [offerID], which was active at unix time [timestamp].
+func getDepositOffer(ctx context.Context, clientURI string, offerID ids.ID, timestamp uint64) (*deposit.Offer, error) {
+	offers, err := getDepositOffers(ctx, clientURI, timestamp)
+	if err != nil {
+		return nil, err
+	}
+	for _, offer := range offers {
+		if offer.ID == offerID {
+			return offer, nil
+		}
+	}
+	return nil, fmt.Errorf("deposit offer %s not found", offerID)
+}
+
+// deposit as reported by the P-chain API
+type apiDeposit struct {
+	deposit.Deposit
+	RewardOwner platformapi.Owner
+}
+
+// Returns the deposits of [depositTxIDs].
+func getDeposits(ctx context.Context, clientURI string, depositTxIDs []ids.ID) ([]apiDeposit, error) {
+	if len(depositTxIDs) == 0 {
+		return nil, nil
+	}
+	// not exposed by the platformvm client
+	requester := rpc.NewEndpointRequester(clientURI + "/ext/P")
+	reply := platformvm.GetDepositsReply{}
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	if err := requester.SendRequest(cctx, "platform.getDeposits", &platformvm.GetDepositsArgs{DepositTxIDs: depositTxIDs}, &reply); err != nil {
+		return nil, err
+	}
+	deposits := make([]apiDeposit, len(reply.Deposits))
+	for i, d := range reply.Deposits {
+		deposits[i] = apiDeposit{
+			Deposit: deposit.Deposit{
+				DepositOfferID:      d.DepositOfferID,
+				UnlockedAmount:      uint64(d.UnlockedAmount),
+				ClaimedRewardAmount: uint64(d.ClaimedRewardAmount),
+				Start:               uint64(d.Start),
+				Duration:            d.Duration,
+				Amount:              uint64(d.Amount),
+			},
+			RewardOwner: d.RewardOwner,
+		}
+	}
+	return deposits, nil
+}
+
+// Returns the validator and expired deposit rewards claimable by [addr].
+func getClaimableTreasuryReward(ctx context.Context, clientURI string, networkID uint32, addr ids.ShortID) (uint64, error) {
+	addrStr, err := address.Format("P", constants.GetHRP(networkID), addr.Bytes())
+	if err != nil {
+		return 0, err
+	}
+	// not exposed by the platformvm client
+	requester := rpc.NewEndpointRequester(clientURI + "/ext/P")
+	reply := platformvm.GetClaimablesReply{}
+	args := platformvm.GetClaimablesArgs{
+		Owners: []platformapi.Owner{{Threshold: 1, Addresses: []string{addrStr}}},
+	}
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	if err := requester.SendRequest(cctx, "platform.getClaimables", &args, &reply); err != nil {
+		return 0, err
+	}
+	reward := uint64(0)
+	for _, claimable := range reply.Claimables {
+		if reward, err = math.Add64(reward, uint64(claimable.ValidatorRewards)); err != nil {
+			return 0, err
+		}
+		if reward, err = math.Add64(reward, uint64(claimable.ExpiredDepositRewards)); err != nil {
+			return 0, err
+		}
+	}
+	return reward, nil
+}
+
+// Returns an error if [owner] is not the address of [w] alone.
+func (w *wallet) checkRewardOwner(owner platformapi.Owner) error {
+	if len(owner.Addresses) == 1 && owner.Threshold == 1 {
+		if addr, err := parseAddress(owner.Addresses[0]); err == nil && addr == w.addr {
+			return nil
+		}
+	}
+	return fmt.Errorf("rewards not owned by the funding key, but by %v", owner.Addresses)
+}
+
+// Issues a DepositTx of [amount] with offer [offerID] for [duration] seconds,
+// rewarding the address of [w].
+func (w *wallet) issueDepositTx(
+	offerID ids.ID,
+	amount uint64,
+	duration uint32,
+	options ...common.Option,
+) (ids.ID, error) {
+	ctx := common.NewOptions(options).Context()
+	ins, outs, signers, err := w.lock(ctx, amount, w.pBackend.BaseTxFee(), locked.StateDeposited)
+	if err != nil {
+		return ids.Empty, err
+	}
+	utx := &txs.DepositTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    w.pBackend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		DepositOfferID:  offerID,
+		DepositDuration: duration,
+		RewardsOwner: &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{w.addr},
+		},
+	}
+	return w.issueSignedTx(utx, signers, options...)
+}
+
+// Issues a ClaimTx of [claimables] owned by the address of [w] to [claimTo].
+func (w *wallet) issueClaimTx(
+	claimables []txs.ClaimAmount,
+	claimTo *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (ids.ID, error) {
+	ctx := common.NewOptions(options).Context()
+	ins, outs, signers, err := w.lock(ctx, 0, w.pBackend.BaseTxFee(), locked.StateUnlocked)
+	if err != nil {
+		return ids.Empty, err
+	}
+	for i := range claimables {
+		claimables[i].OwnerAuth = &secp256k1fx.Input{SigIndices: []uint32{0}}
+		signers = append(signers, []*secp256k1.PrivateKey{w.key})
+		outs = append(outs, &avax.TransferableOutput{
+			Asset: avax.Asset{ID: w.pBackend.AVAXAssetID()},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          claimables[i].Amount,
+				OutputOwners: *claimTo,
+			},
+		})
+	}
+	avax.SortTransferableOutputs(outs, txs.Codec)
+	utx := &txs.ClaimTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    w.pBackend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		Claimables: claimables,
+	}
+	return w.issueSignedTx(utx, signers, options...)
+}
+
+// Issues an UnlockDepositTx unlocking up to [unlockableAmounts] from the
+// deposited utxos of [w], by deposit tx ID.
+// Returns the ID of the tx and the unlocked amount.
+func (w *wallet) issueUnlockDepositTx(
+	unlockableAmounts map[ids.ID]uint64,
+	options ...common.Option,
+) (ids.ID, uint64, error) {
+	ctx := common.NewOptions(options).Context()
+	utxos, err := w.pBackend.UTXOs(ctx, constants.PlatformChainID)
+	if err != nil {
+		return ids.Empty, 0, err
+	}
+	kc := secp256k1fx.NewKeychain(w.keys...)
+	assetID := w.pBackend.AVAXAssetID()
+	ins := []*avax.TransferableInput{}
+	outs := []*avax.TransferableOutput{}
+	signers := [][]*secp256k1.PrivateKey{}
+	unlocked := uint64(0)
+	for _, u := range utxos {
+		out, ok := u.Out.(*locked.Out)
+		if !ok || unlockableAmounts[out.DepositTxID] == 0 {
+			continue
+		}
+		innerOut, ok := out.TransferableOut.(*secp256k1fx.TransferOutput)
+		if !ok {
+			continue
+		}
+		inIntf, inSigners, err := kc.Spend(innerOut, 0)
+		if err != nil {
+			// not spendable by the key of [w]
+			continue
+		}
+		in, ok := inIntf.(avax.TransferableIn)
+		if !ok {
+			continue
+		}
+		ins = append(ins, &avax.TransferableInput{
+			UTXOID: u.UTXOID,
+			Asset:  avax.Asset{ID: assetID},
+			In: &locked.In{
+				IDs:            out.IDs,
+				TransferableIn: in,
+			},
+		})
+		signers = append(signers, inSigners)
+
+		amountToUnlock := math.Min(unlockableAmounts[out.DepositTxID], in.Amount())
+		unlockableAmounts[out.DepositTxID] -= amountToUnlock
+		unlocked += amountToUnlock
+		unlockedOut := &secp256k1fx.TransferOutput{
+			Amt:          amountToUnlock,
+			OutputOwners: innerOut.OutputOwners,
+		}
+		if newLockIDs := out.Unlock(locked.StateDeposited); newLockIDs.IsLocked() {
+			outs = append(outs, &avax.TransferableOutput{
+				Asset: avax.Asset{ID: assetID},
+				Out:   &locked.Out{IDs: newLockIDs, TransferableOut: unlockedOut},
+			})
+		} else {
+			outs = append(outs, &avax.TransferableOutput{
+				Asset: avax.Asset{ID: assetID},
+				Out:   unlockedOut,
+			})
+		}
+		if remaining := in.Amount() - amountToUnlock; remaining > 0 {
+			outs = append(outs, &avax.TransferableOutput{
+				Asset: avax.Asset{ID: assetID},
+				Out: &locked.Out{
+					IDs: out.IDs,
+					TransferableOut: &secp256k1fx.TransferOutput{
+						Amt:          remaining,
+						OutputOwners: innerOut.OutputOwners,
+					},
+				},
+			})
+		}
+	}
+	if unlocked == 0 {
+		return ids.Empty, 0, nil
+	}
+
+	feeIns, feeOuts, feeSigners, err := w.lock(ctx, 0, w.pBackend.BaseTxFee(), locked.StateUnlocked)
+	if err != nil {
+		return ids.Empty, 0, err
+	}
+	ins = append(ins, feeIns...)
+	outs = append(outs, feeOuts...)
+	signers = append(signers, feeSigners...)
+	avax.SortTransferableInputsWithSigners(ins, signers)
+	avax.SortTransferableOutputs(outs, txs.Codec)
+
+	utx := &txs.UnlockDepositTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    w.pBackend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+	}
+	txID, err := w.issueSignedTx(utx, signers, options...)
+	if err != nil {
+		return ids.Empty, 0, err
+	}
+	return txID, unlocked, nil
+}
@@ -0,0 +1,181 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueDepositTx(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	fundingKey := newTestKey(t)
+	fundingAddr := fundingKey.PublicKey().Address()
+	w, pWallet := newTestWallet(t, []*secp256k1.PrivateKey{fundingKey},
+		newTestUTXO(10*testTxFee, fundingAddr, locked.IDsEmpty),
+	)
+	offerID := ids.GenerateTestID()
+
+	_, err := w.issueDepositTx(offerID, 5*testTxFee, 100)
+	require.NoError(err)
+	require.Len(pWallet.issued, 1)
+	tx := pWallet.issued[0]
+	utx, ok := tx.Unsigned.(*txs.DepositTx)
+	require.True(ok)
+	require.Equal(offerID, utx.DepositOfferID)
+	require.Equal(uint32(100), utx.DepositDuration)
+	require.Equal(&secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{fundingAddr}}, utx.RewardsOwner)
+	deposited, unlocked := uint64(0), uint64(0)
+	for _, out := range utx.Outs {
+		if lockedOut, ok := out.Out.(*locked.Out); ok {
+			require.Equal(locked.IDs{DepositTxID: locked.ThisTxID}, lockedOut.IDs)
+			deposited += out.Out.Amount()
+			continue
+		}
+		unlocked += out.Out.Amount()
+	}
+	require.Equal(uint64(5*testTxFee), deposited)
+	require.Equal(uint64(4*testTxFee), unlocked)
+	require.Equal([][]ids.ShortID{{fundingAddr}}, getCredSigners(t, tx))
+}
+
+func TestIssueClaimTx(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	fundingKey := newTestKey(t)
+	fundingAddr := fundingKey.PublicKey().Address()
+	w, pWallet := newTestWallet(t, []*secp256k1.PrivateKey{fundingKey},
+		newTestUTXO(5*testTxFee, fundingAddr, locked.IDsEmpty),
+	)
+	claimTo := &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{ids.GenerateTestShortID()}}
+	claimables := []txs.ClaimAmount{
+		{ID: ids.GenerateTestID(), Type: txs.ClaimTypeActiveDepositReward, Amount: 10},
+		{ID: ids.GenerateTestID(), Type: txs.ClaimTypeExpiredDepositReward, Amount: 20},
+	}
+
+	_, err := w.issueClaimTx(claimables, claimTo)
+	require.NoError(err)
+	require.Len(pWallet.issued, 1)
+	tx := pWallet.issued[0]
+	utx, ok := tx.Unsigned.(*txs.ClaimTx)
+	require.True(ok)
+	require.Equal(claimables, utx.Claimables)
+	for _, claimable := range utx.Claimables {
+		require.Equal(&secp256k1fx.Input{SigIndices: []uint32{0}}, claimable.OwnerAuth)
+	}
+	// the fee is burned from the funding utxo, and the claimed amounts go to [claimTo]
+	require.Len(utx.Ins, 1)
+	claimed, change := uint64(0), uint64(0)
+	for _, out := range utx.Outs {
+		transferOut, ok := out.Out.(*secp256k1fx.TransferOutput)
+		require.True(ok)
+		if transferOut.OutputOwners.Equals(claimTo) {
+			claimed += transferOut.Amt
+			continue
+		}
+		change += transferOut.Amt
+	}
+	require.Equal(uint64(30), claimed)
+	require.Equal(uint64(4*testTxFee), change)
+
+	// input creds, then the owner of each claimable
+	require.Equal([][]ids.ShortID{
+		{fundingAddr},
+		{fundingAddr},
+		{fundingAddr},
+	}, getCredSigners(t, tx))
+}
+
+func TestIssueUnlockDepositTx(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	fundingKey := newTestKey(t)
+	fundingAddr := fundingKey.PublicKey().Address()
+	depositTxID, bondedDepositTxID := ids.GenerateTestID(), ids.GenerateTestID()
+	bondTxID := ids.GenerateTestID()
+	deposited := newTestUTXO(10*testTxFee, fundingAddr, locked.IDs{DepositTxID: depositTxID})
+	bondedDeposited := newTestUTXO(4*testTxFee, fundingAddr, locked.IDs{
+		DepositTxID: bondedDepositTxID,
+		BondTxID:    bondTxID,
+	})
+	notOwned := newTestUTXO(10*testTxFee, ids.GenerateTestShortID(), locked.IDs{DepositTxID: depositTxID})
+	fee := newTestUTXO(3*testTxFee, fundingAddr, locked.IDsEmpty)
+	w, pWallet := newTestWallet(t, []*secp256k1.PrivateKey{fundingKey},
+		deposited, bondedDeposited, notOwned, fee,
+	)
+
+	txID, unlocked, err := w.issueUnlockDepositTx(map[ids.ID]uint64{
+		depositTxID:       6 * testTxFee,
+		bondedDepositTxID: 4 * testTxFee,
+	})
+	require.NoError(err)
+	require.Equal(uint64(10*testTxFee), unlocked)
+	require.Len(pWallet.issued, 1)
+	tx := pWallet.issued[0]
+	require.Equal(tx.ID(), txID)
+	utx, ok := tx.Unsigned.(*txs.UnlockDepositTx)
+	require.True(ok)
+
+	// the deposited utxos of the funding key, and the fee utxo
+	inIDs := make([]ids.ID, len(utx.Ins))
+	for i, in := range utx.Ins {
+		inIDs[i] = in.InputID()
+		if lockedIn, ok := in.In.(*locked.In); ok {
+			require.True(lockedIn.IDs.DepositTxID == depositTxID || lockedIn.IDs.DepositTxID == bondedDepositTxID)
+		}
+	}
+	require.ElementsMatch([]ids.ID{deposited.InputID(), bondedDeposited.InputID(), fee.InputID()}, inIDs)
+
+	outs := map[locked.IDs]uint64{}
+	for _, out := range utx.Outs {
+		lockIDs := locked.IDsEmpty
+		if lockedOut, ok := out.Out.(*locked.Out); ok {
+			lockIDs = lockedOut.IDs
+		}
+		outs[lockIDs] += out.Out.Amount()
+	}
+	require.Equal(map[locked.IDs]uint64{
+		// unlocked from the deposit, and the fee change
+		locked.IDsEmpty: 6*testTxFee + 2*testTxFee,
+		// the rest of the partially unlocked deposit
+		{DepositTxID: depositTxID}: 4 * testTxFee,
+		// still bonded after the deposit unlock
+		{BondTxID: bondTxID}: 4 * testTxFee,
+	}, outs)
+
+	// one cred per input, all signed by the funding key
+	require.Equal([][]ids.ShortID{
+		{fundingAddr},
+		{fundingAddr},
+		{fundingAddr},
+	}, getCredSigners(t, tx))
+}
+
+func TestIssueUnlockDepositTxNothingToUnlock(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	fundingKey := newTestKey(t)
+	fundingAddr := fundingKey.PublicKey().Address()
+	w, pWallet := newTestWallet(t, []*secp256k1.PrivateKey{fundingKey},
+		newTestUTXO(10*testTxFee, fundingAddr, locked.IDs{DepositTxID: ids.GenerateTestID()}),
+		newTestUTXO(3*testTxFee, fundingAddr, locked.IDsEmpty),
+	)
+
+	txID, unlocked, err := w.issueUnlockDepositTx(map[ids.ID]uint64{ids.GenerateTestID(): testTxFee})
+	require.NoError(err)
+	require.Equal(ids.Empty, txID)
+	require.Zero(unlocked)
+	require.Empty(pWallet.issued)
+}
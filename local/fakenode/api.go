@@ -0,0 +1,155 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fakenode
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/api/health"
+	"github.com/ava-labs/avalanchego/api/info"
+	"github.com/ava-labs/avalanchego/network/peer"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/gorilla/rpc/v2"
+)
+
+var errUnknownChain = errors.New("unknown chain")
+
+// Serves the fake APIs with the same JSON-RPC codec as camino-node.
+func (n *Node) newHandler() http.Handler {
+	mux := http.NewServeMux()
+	for _, service := range []struct {
+		name     string
+		receiver interface{}
+		paths    []string
+	}{
+		{"info", &infoService{n: n}, []string{"/ext/info"}},
+		{"health", &healthService{n: n}, []string{"/ext/health"}},
+		{"platform", &platformService{n: n}, []string{"/ext/P", "/ext/bc/P"}},
+	} {
+		server := rpc.NewServer()
+		server.RegisterCodec(json.NewCodec(), "application/json")
+		server.RegisterCodec(json.NewCodec(), "application/json;charset=UTF-8")
+		if err := server.RegisterService(service.receiver, service.name); err != nil {
+			// only fails on malformed receivers, which is a programming error
+			panic(err)
+		}
+		for _, path := range service.paths {
+			mux.Handle(path, server)
+		}
+	}
+	return mux
+}
+
+type infoService struct {
+	n *Node
+}
+
+func (s *infoService) GetNodeID(_ *http.Request, _ *struct{}, reply *info.GetNodeIDReply) error {
+	reply.NodeID = s.n.nodeID
+	return nil
+}
+
+func (s *infoService) GetNodeVersion(_ *http.Request, _ *struct{}, reply *info.GetNodeVersionReply) error {
+	reply.Version = s.n.version
+	reply.VMVersions = map[string]string{}
+	return nil
+}
+
+func (s *infoService) GetNodeIP(_ *http.Request, _ *struct{}, reply *info.GetNodeIPReply) error {
+	reply.IP = net.JoinHostPort(defaultHTTPHost, strconv.Itoa(int(s.n.p2pPort)))
+	return nil
+}
+
+func (s *infoService) GetNetworkID(_ *http.Request, _ *struct{}, reply *info.GetNetworkIDReply) error {
+	reply.NetworkID = json.Uint32(s.n.networkID)
+	return nil
+}
+
+func (s *infoService) GetNetworkName(_ *http.Request, _ *struct{}, reply *info.GetNetworkNameReply) error {
+	reply.NetworkName = constants.NetworkName(s.n.networkID)
+	return nil
+}
+
+// Reports every other running fake node of the same creator as a peer.
+func (s *infoService) Peers(_ *http.Request, _ *info.PeersArgs, reply *info.PeersReply) error {
+	reply.Peers = []info.Peer{}
+	for _, p := range s.n.creator.runningPeers(s.n) {
+		reply.Peers = append(reply.Peers, info.Peer{
+			Info: peer.Info{
+				IP:      net.JoinHostPort(defaultHTTPHost, strconv.Itoa(int(p.p2pPort))),
+				ID:      p.nodeID,
+				Version: p.version,
+			},
+		})
+	}
+	reply.NumPeers = json.Uint64(len(reply.Peers))
+	return nil
+}
+
+func (s *infoService) IsBootstrapped(_ *http.Request, args *info.IsBootstrappedArgs, reply *info.IsBootstrappedResponse) error {
+	switch args.Chain {
+	case "P", "X", "C":
+	default:
+		return fmt.Errorf("%w: %q", errUnknownChain, args.Chain)
+	}
+	reply.IsBootstrapped = s.n.IsBootstrapped()
+	return nil
+}
+
+type healthService struct {
+	n *Node
+}
+
+func (s *healthService) reply(reply *health.APIReply) {
+	reply.Checks = map[string]health.Result{}
+	reply.Healthy = s.n.IsHealthy()
+}
+
+func (s *healthService) Health(_ *http.Request, _ *struct{}, reply *health.APIReply) error {
+	s.reply(reply)
+	return nil
+}
+
+func (s *healthService) Readiness(_ *http.Request, _ *struct{}, reply *health.APIReply) error {
+	s.reply(reply)
+	return nil
+}
+
+func (*healthService) Liveness(_ *http.Request, _ *struct{}, reply *health.APIReply) error {
+	reply.Checks = map[string]health.Result{}
+	reply.Healthy = true
+	return nil
+}
+
+// The fake P-chain has no subnets and no custom blockchains.
+type platformService struct {
+	n *Node
+}
+
+func (*platformService) GetHeight(_ *http.Request, _ *struct{}, reply *api.GetHeightResponse) error {
+	reply.Height = 0
+	return nil
+}
+
+func (*platformService) GetBlockchains(_ *http.Request, _ *struct{}, reply *platformvm.GetBlockchainsResponse) error {
+	reply.Blockchains = []platformvm.APIBlockchain{}
+	return nil
+}
+
+func (*platformService) GetSubnets(_ *http.Request, _ *platformvm.GetSubnetsArgs, reply *platformvm.GetSubnetsResponse) error {
+	reply.Subnets = []platformvm.APISubnet{}
+	return nil
+}
+
+func (*platformService) GetCurrentValidators(_ *http.Request, _ *platformvm.GetCurrentValidatorsArgs, reply *platformvm.GetCurrentValidatorsReply) error {
+	reply.Validators = []interface{}{}
+	return nil
+}
@@ -0,0 +1,508 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package fakenode implements an in-memory camino-node backend for tests.
+//
+// A fake node doesn't run any consensus. It serves a minimal info, health
+// and P-chain API on its HTTP port, keeps a small state file in its DB dir
+// so snapshots and restarts can be verified, and lets tests control when it
+// bootstraps, whether it is healthy, and when it crashes.
+package fakenode
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/local"
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/network/node/status"
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+const (
+	// DefaultVersion is the version output reported for every binary path.
+	DefaultVersion = "camino-node: v0.4.10, commit: fake"
+
+	defaultHTTPHost = "127.0.0.1"
+	stateFileName   = "fake-node.json"
+	logFileName     = "main.log"
+	shutdownTimeout = 5 * time.Second
+)
+
+var (
+	_ local.NodeProcessCreator = (*ProcessCreator)(nil)
+	_ local.NodeProcess        = (*Node)(nil)
+
+	ErrNodeNotFound = errors.New("fake node not found")
+)
+
+// Behavior controls how a fake node started by a [ProcessCreator] behaves.
+// Behavior can be changed at runtime through the [Node] methods.
+type Behavior struct {
+	// If non-nil, starting the node fails with this error.
+	StartErr error
+	// Time after start until the node reports being bootstrapped.
+	BootstrapDelay time.Duration
+	// If true, the node only bootstraps once at least one of its
+	// bootstrap peers is a running, bootstrapped fake node.
+	RequireBeacons bool
+	// If true, the node reports unhealthy even once bootstrapped.
+	Unhealthy bool
+	// If non-zero, the node crashes this long after start,
+	// exiting with [CrashExitCode].
+	CrashAfter    time.Duration
+	CrashExitCode int
+}
+
+// ProcessCreator creates fake node processes.
+// It is safe for concurrent use.
+type ProcessCreator struct {
+	lock sync.Mutex
+
+	version         string
+	defaultBehavior Behavior
+	// node name --> behavior overriding [defaultBehavior]
+	behaviors map[string]Behavior
+	// node name --> last process started for it
+	nodes map[string]*Node
+}
+
+func NewProcessCreator() *ProcessCreator {
+	return &ProcessCreator{
+		version:   DefaultVersion,
+		behaviors: map[string]Behavior{},
+		nodes:     map[string]*Node{},
+	}
+}
+
+// SetVersion sets the version output reported for every binary path.
+func (pc *ProcessCreator) SetVersion(version string) {
+	pc.lock.Lock()
+	defer pc.lock.Unlock()
+
+	pc.version = version
+}
+
+// SetDefaultBehavior sets the behavior of nodes started from now on
+// that have no behavior of their own.
+func (pc *ProcessCreator) SetDefaultBehavior(behavior Behavior) {
+	pc.lock.Lock()
+	defer pc.lock.Unlock()
+
+	pc.defaultBehavior = behavior
+}
+
+// SetBehavior sets the behavior of node [nodeName] the next time it starts.
+func (pc *ProcessCreator) SetBehavior(nodeName string, behavior Behavior) {
+	pc.lock.Lock()
+	defer pc.lock.Unlock()
+
+	pc.behaviors[nodeName] = behavior
+}
+
+// Node returns the last process started for node [nodeName].
+func (pc *ProcessCreator) Node(nodeName string) (*Node, error) {
+	pc.lock.Lock()
+	defer pc.lock.Unlock()
+
+	n, ok := pc.nodes[nodeName]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrNodeNotFound, nodeName)
+	}
+	return n, nil
+}
+
+// See local.NodeProcessCreator
+func (pc *ProcessCreator) GetNodeVersion(node.Config) (string, error) {
+	pc.lock.Lock()
+	defer pc.lock.Unlock()
+
+	return pc.version, nil
+}
+
+// See local.NodeProcessCreator
+func (pc *ProcessCreator) NewNodeProcess(config node.Config, args ...string) (local.NodeProcess, error) {
+	pc.lock.Lock()
+	behavior, ok := pc.behaviors[config.Name]
+	if !ok {
+		behavior = pc.defaultBehavior
+	}
+	version := pc.version
+	pc.lock.Unlock()
+
+	if behavior.StartErr != nil {
+		return nil, behavior.StartErr
+	}
+
+	n, err := newNode(pc, config, version, behavior, args)
+	if err != nil {
+		return nil, err
+	}
+
+	pc.lock.Lock()
+	pc.nodes[config.Name] = n
+	pc.lock.Unlock()
+
+	n.start()
+	return n, nil
+}
+
+// Returns the running node with ID [nodeID], if any.
+func (pc *ProcessCreator) runningNode(nodeID ids.NodeID) (*Node, bool) {
+	pc.lock.Lock()
+	defer pc.lock.Unlock()
+
+	for _, n := range pc.nodes {
+		if n.nodeID == nodeID && n.Status() == status.Running {
+			return n, true
+		}
+	}
+	return nil, false
+}
+
+// Returns all running nodes other than [self].
+func (pc *ProcessCreator) runningPeers(self *Node) []*Node {
+	pc.lock.Lock()
+	defer pc.lock.Unlock()
+
+	peers := []*Node{}
+	for _, n := range pc.nodes {
+		if n != self && n.Status() == status.Running {
+			peers = append(peers, n)
+		}
+	}
+	return peers
+}
+
+// persisted in the node DB dir
+type nodeState struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	Starts int        `json:"starts"`
+}
+
+// Node is a fake node process.
+type Node struct {
+	lock sync.RWMutex
+
+	creator *ProcessCreator
+
+	name      string
+	nodeID    ids.NodeID
+	version   string
+	networkID uint32
+	flags     map[string]string
+	apiPort   uint16
+	p2pPort   uint16
+	dbDir     string
+	logsDir   string
+	beacons   []ids.NodeID
+
+	behavior  Behavior
+	startTime time.Time
+	// if non-nil, overrides the bootstrap behavior
+	bootstrapped *bool
+	starts       int
+
+	state      status.Status
+	exitCode   int
+	listener   net.Listener
+	httpServer *http.Server
+	crashTimer *time.Timer
+}
+
+func newNode(creator *ProcessCreator, nodeConfig node.Config, version string, behavior Behavior, args []string) (*Node, error) {
+	flags := map[string]string{}
+	for _, arg := range args {
+		k, v, _ := strings.Cut(strings.TrimPrefix(arg, "--"), "=")
+		flags[k] = v
+	}
+
+	nodeID, err := utils.ToNodeID([]byte(nodeConfig.StakingKey), []byte(nodeConfig.StakingCert))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get node ID: %w", err)
+	}
+	networkID, err := strconv.ParseUint(flags[config.NetworkNameKey], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s flag: %w", config.NetworkNameKey, err)
+	}
+	apiPort, err := strconv.ParseUint(flags[config.HTTPPortKey], 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s flag: %w", config.HTTPPortKey, err)
+	}
+	p2pPort, err := strconv.ParseUint(flags[config.StakingPortKey], 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s flag: %w", config.StakingPortKey, err)
+	}
+	beacons := []ids.NodeID{}
+	if bootstrapIDs := flags[config.BootstrapIDsKey]; bootstrapIDs != "" {
+		for _, s := range strings.Split(bootstrapIDs, ",") {
+			beaconID, err := ids.NodeIDFromString(s)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s flag: %w", config.BootstrapIDsKey, err)
+			}
+			beacons = append(beacons, beaconID)
+		}
+	}
+	httpHost := flags[config.HTTPHostKey]
+	if httpHost == "" {
+		httpHost = defaultHTTPHost
+	}
+
+	n := &Node{
+		creator:   creator,
+		name:      nodeConfig.Name,
+		nodeID:    nodeID,
+		version:   version,
+		networkID: uint32(networkID),
+		flags:     flags,
+		apiPort:   uint16(apiPort),
+		p2pPort:   uint16(p2pPort),
+		dbDir:     filepath.Join(flags[config.DBPathKey], constants.NetworkName(uint32(networkID))),
+		logsDir:   flags[config.LogsDirKey],
+		beacons:   beacons,
+		behavior:  behavior,
+	}
+
+	if err := n.loadState(); err != nil {
+		return nil, err
+	}
+
+	n.listener, err = net.Listen("tcp", net.JoinHostPort(httpHost, strconv.Itoa(int(apiPort))))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't listen on API port: %w", err)
+	}
+	n.httpServer = &http.Server{ //nolint // TODO add ReadHeaderTimeout
+		Handler: n.newHandler(),
+	}
+	return n, nil
+}
+
+// Reads and updates the state file in [n.dbDir].
+func (n *Node) loadState() error {
+	if err := os.MkdirAll(n.dbDir, os.ModePerm); err != nil {
+		return err
+	}
+	statePath := filepath.Join(n.dbDir, stateFileName)
+	state := nodeState{NodeID: n.nodeID}
+	b, err := os.ReadFile(statePath)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(b, &state); err != nil {
+			return fmt.Errorf("couldn't unmarshal %s: %w", statePath, err)
+		}
+		if state.NodeID != n.nodeID {
+			return fmt.Errorf("db dir %s belongs to node %s, not %s", n.dbDir, state.NodeID, n.nodeID)
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return err
+	}
+	state.Starts++
+	n.starts = state.Starts
+	b, err = json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(statePath, b, 0o600)
+}
+
+func (n *Node) start() {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.state = status.Running
+	n.startTime = time.Now()
+	go func() {
+		_ = n.httpServer.Serve(n.listener)
+	}()
+	if n.behavior.CrashAfter != 0 {
+		n.crashTimer = time.AfterFunc(n.behavior.CrashAfter, func() {
+			n.Crash(n.behavior.CrashExitCode)
+		})
+	}
+	n.logf("fake node %s started (start #%d) with API port %d", n.nodeID, n.starts, n.apiPort)
+}
+
+// Appends a line to the node log.
+// Assumes [n.lock] is held.
+func (n *Node) logf(format string, args ...interface{}) {
+	if n.logsDir == "" {
+		return
+	}
+	if err := os.MkdirAll(n.logsDir, os.ModePerm); err != nil {
+		return
+	}
+	f, err := os.OpenFile(filepath.Join(n.logsDir, logFileName), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	_, _ = fmt.Fprintf(f, "[%s] %s\n", time.Now().Format(time.RFC3339Nano), fmt.Sprintf(format, args...))
+}
+
+// Stops the process, recording [exitCode].
+// Assumes [n.lock] is held.
+func (n *Node) exit(ctx context.Context, exitCode int) {
+	n.state = status.Stopping
+	if n.crashTimer != nil {
+		n.crashTimer.Stop()
+	}
+	_ = n.httpServer.Shutdown(ctx)
+	// not closed by Shutdown if Serve didn't start yet
+	_ = n.listener.Close()
+	n.exitCode = exitCode
+	n.state = status.Stopped
+}
+
+// See local.NodeProcess
+func (n *Node) Stop(ctx context.Context) int {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if n.state == status.Stopped {
+		return n.exitCode
+	}
+	ctx, cancel := context.WithTimeout(ctx, shutdownTimeout)
+	defer cancel()
+	n.exit(ctx, 0)
+	n.logf("fake node stopped")
+	return n.exitCode
+}
+
+// See local.NodeProcess
+func (n *Node) Status() status.Status {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+
+	return n.state
+}
+
+// Crash makes the process exit unexpectedly with [exitCode].
+func (n *Node) Crash(exitCode int) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if n.state == status.Stopped {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	n.exit(ctx, exitCode)
+	n.logf("fake node crashed with exit code %d", exitCode)
+}
+
+// SetHealthy sets whether the node reports healthy once bootstrapped.
+func (n *Node) SetHealthy(healthy bool) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.behavior.Unhealthy = !healthy
+}
+
+// SetBootstrapped forces the node to report as bootstrapped or not,
+// regardless of its bootstrap behavior.
+func (n *Node) SetBootstrapped(bootstrapped bool) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.bootstrapped = &bootstrapped
+}
+
+// IsBootstrapped reports whether the node is done bootstrapping.
+func (n *Node) IsBootstrapped() bool {
+	return n.isBootstrapped(set.Set[ids.NodeID]{})
+}
+
+// [visited] holds the nodes already being checked, to break beacon cycles.
+func (n *Node) isBootstrapped(visited set.Set[ids.NodeID]) bool {
+	n.lock.RLock()
+	if n.state != status.Running {
+		n.lock.RUnlock()
+		return false
+	}
+	if n.bootstrapped != nil {
+		defer n.lock.RUnlock()
+		return *n.bootstrapped
+	}
+	if time.Since(n.startTime) < n.behavior.BootstrapDelay {
+		n.lock.RUnlock()
+		return false
+	}
+	requireBeacons := n.behavior.RequireBeacons
+	n.lock.RUnlock()
+
+	if !requireBeacons || len(n.beacons) == 0 {
+		return true
+	}
+	visited.Add(n.nodeID)
+	for _, beaconID := range n.beacons {
+		if visited.Contains(beaconID) {
+			continue
+		}
+		if beacon, ok := n.creator.runningNode(beaconID); ok && beacon.isBootstrapped(visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsHealthy reports whether the node reports healthy.
+func (n *Node) IsHealthy() bool {
+	if !n.IsBootstrapped() {
+		return false
+	}
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+
+	return !n.behavior.Unhealthy
+}
+
+// Name returns the node name.
+func (n *Node) Name() string {
+	return n.name
+}
+
+// NodeID returns the node ID derived from the node staking cert.
+func (n *Node) NodeID() ids.NodeID {
+	return n.nodeID
+}
+
+// APIPort returns the port the fake API is served on.
+func (n *Node) APIPort() uint16 {
+	return n.apiPort
+}
+
+// P2PPort returns the staking port the node was given.
+func (n *Node) P2PPort() uint16 {
+	return n.p2pPort
+}
+
+// Flag returns the value of flag [name] the node was started with.
+func (n *Node) Flag(name string) (string, bool) {
+	v, ok := n.flags[name]
+	return v, ok
+}
+
+// Beacons returns the IDs of the nodes this node bootstraps from.
+func (n *Node) Beacons() []ids.NodeID {
+	return append([]ids.NodeID{}, n.beacons...)
+}
+
+// Starts returns how many times a node has been started on this node's DB,
+// including this process. It carries over across restarts and snapshots.
+func (n *Node) Starts() int {
+	return n.starts
+}
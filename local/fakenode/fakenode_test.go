@@ -0,0 +1,268 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fakenode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/local"
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/network/node/status"
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+const healthyTimeout = 30 * time.Second
+
+func newTestNetwork(t *testing.T, pc *ProcessCreator, snapshotsDir string, numNodes uint32) network.Network {
+	return newTestNetworkWithConfig(t, pc, snapshotsDir, numNodes, func(*network.Config) {})
+}
+
+func newTestNetworkWithConfig(
+	t *testing.T,
+	pc *ProcessCreator,
+	snapshotsDir string,
+	numNodes uint32,
+	updateConfig func(*network.Config),
+) network.Network {
+	require := require.New(t)
+
+	networkConfig, err := local.NewDefaultConfigNNodes("camino-node", numNodes)
+	require.NoError(err)
+	for i := range networkConfig.NodeConfigs {
+		delete(networkConfig.NodeConfigs[i].Flags, config.HTTPPortKey)
+		delete(networkConfig.NodeConfigs[i].Flags, config.StakingPortKey)
+	}
+	updateConfig(&networkConfig)
+	nw, err := local.NewNetworkWithNodeProcessCreator(logging.NoLog{}, networkConfig, t.TempDir(), snapshotsDir, false, pc)
+	require.NoError(err)
+	t.Cleanup(func() {
+		_ = nw.Stop(context.Background())
+	})
+	return nw
+}
+
+func TestHealthAndCrash(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	pc := NewProcessCreator()
+	nw := newTestNetwork(t, pc, t.TempDir(), 3)
+
+	ctx, cancel := context.WithTimeout(context.Background(), healthyTimeout)
+	defer cancel()
+	require.NoError(nw.Healthy(ctx))
+
+	n, err := pc.Node("node2")
+	require.NoError(err)
+	node2, err := nw.GetNode("node2")
+	require.NoError(err)
+	require.Equal(node2.GetNodeID(), n.NodeID())
+	require.Equal(node2.GetAPIPort(), n.APIPort())
+
+	peers, err := node2.GetAPIClient().InfoAPI().Peers(ctx)
+	require.NoError(err)
+	require.Len(peers, 2)
+
+	n.SetHealthy(false)
+	shortCtx, shortCancel := context.WithTimeout(ctx, 3*time.Second)
+	defer shortCancel()
+	require.Error(nw.Healthy(shortCtx))
+	n.SetHealthy(true)
+	require.NoError(nw.Healthy(ctx))
+
+	n.Crash(2)
+	require.Equal(status.Stopped, node2.Status())
+	err = nw.Healthy(ctx)
+	require.ErrorContains(err, "stopped unexpectedly")
+}
+
+func TestBootstrapBehavior(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	pc := NewProcessCreator()
+	pc.SetDefaultBehavior(Behavior{RequireBeacons: true})
+	pc.SetBehavior("node1", Behavior{BootstrapDelay: time.Hour})
+	nw := newTestNetwork(t, pc, t.TempDir(), 2)
+
+	n2, err := pc.Node("node2")
+	require.NoError(err)
+	require.Len(n2.Beacons(), 1)
+	require.False(n2.IsBootstrapped())
+
+	n1, err := pc.Node("node1")
+	require.NoError(err)
+	n1.SetBootstrapped(true)
+	require.True(n2.IsBootstrapped())
+
+	ctx, cancel := context.WithTimeout(context.Background(), healthyTimeout)
+	defer cancel()
+	require.NoError(nw.Healthy(ctx))
+}
+
+func TestRestartAndSnapshot(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	pc := NewProcessCreator()
+	snapshotsDir := t.TempDir()
+	nw := newTestNetwork(t, pc, snapshotsDir, 2)
+	ctx, cancel := context.WithTimeout(context.Background(), healthyTimeout)
+	defer cancel()
+	require.NoError(nw.Healthy(ctx))
+
+	require.NoError(nw.RestartNode(ctx, "node1", "", "", "", nil, nil, nil))
+	n1, err := pc.Node("node1")
+	require.NoError(err)
+	require.Equal(2, n1.Starts())
+	require.NoError(nw.Healthy(ctx))
+
+	_, err = nw.SaveSnapshot(ctx, "fake")
+	require.NoError(err)
+	n1, err = pc.Node("node1")
+	require.NoError(err)
+	require.Equal(status.Stopped, n1.Status())
+	dbCopies := nw.GetSnapshotDBCopies()
+	require.Contains(dbCopies, "node1")
+	result := dbCopies["node1"]
+	require.Positive(result.Reflinked + result.Hardlinked + result.Copied)
+
+	nw, err = local.NewNetworkFromSnapshotWithNodeProcessCreator(
+		logging.NoLog{}, "fake", t.TempDir(), snapshotsDir, "", "", nil, nil, nil, nil, true, pc,
+	)
+	require.NoError(err)
+	defer func() {
+		_ = nw.Stop(context.Background())
+	}()
+	require.NoError(nw.Healthy(ctx))
+	n1, err = pc.Node("node1")
+	require.NoError(err)
+	// the db state was carried over by the snapshot
+	require.Equal(3, n1.Starts())
+}
+
+func TestDedupSnapshots(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	pc := NewProcessCreator()
+	snapshotsDir := t.TempDir()
+	nw := newTestNetworkWithConfig(t, pc, snapshotsDir, 2, func(networkConfig *network.Config) {
+		networkConfig.DedupSnapshots = true
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), healthyTimeout)
+	defer cancel()
+	require.NoError(nw.Healthy(ctx))
+
+	snapshotDir, err := nw.SaveSnapshot(ctx, "first")
+	require.NoError(err)
+	require.Empty(nw.GetSnapshotDBCopies())
+	// node dbs are in the store, not in the snapshot
+	_, err = os.Stat(filepath.Join(snapshotDir, "db-manifest.json"))
+	require.NoError(err)
+	entries, err := os.ReadDir(filepath.Join(snapshotDir, "db"))
+	require.NoError(err)
+	require.Empty(entries)
+	chunks, err := filepath.Glob(filepath.Join(snapshotsDir, ".store", "chunks", "*", "*"))
+	require.NoError(err)
+	require.NotEmpty(chunks)
+
+	// the setting is kept by the snapshot
+	nw, err = local.NewNetworkFromSnapshotWithNodeProcessCreator(
+		logging.NoLog{}, "first", t.TempDir(), snapshotsDir, "", "", nil, nil, nil, nil, true, pc,
+	)
+	require.NoError(err)
+	require.NoError(nw.Healthy(ctx))
+	n1, err := pc.Node("node1")
+	require.NoError(err)
+	require.Equal(2, n1.Starts())
+	_, err = nw.SaveSnapshot(ctx, "second")
+	require.NoError(err)
+	require.NoError(nw.RemoveSnapshot("first"))
+
+	nw, err = local.NewNetworkFromSnapshotWithNodeProcessCreator(
+		logging.NoLog{}, "second", t.TempDir(), snapshotsDir, "", "", nil, nil, nil, nil, true, pc,
+	)
+	require.NoError(err)
+	defer func() {
+		_ = nw.Stop(context.Background())
+	}()
+	require.NoError(nw.Healthy(ctx))
+	n1, err = pc.Node("node1")
+	require.NoError(err)
+	require.Equal(3, n1.Starts())
+
+	// removing the last snapshot collects all chunks
+	require.NoError(nw.RemoveSnapshot("second"))
+	chunks, err = filepath.Glob(filepath.Join(snapshotsDir, ".store", "chunks", "*", "*"))
+	require.NoError(err)
+	require.Empty(chunks)
+}
+
+func TestSnapshotKeys(t *testing.T) {
+	t.Parallel()
+
+	const (
+		ewoqKey = "PrivateKey-ewoqjP7PxY4yr3iLTpLisriqt94hdyDFNgchSxGGztUrTXtNN"
+		vmrqKey = "PrivateKey-vmRQiZeXEXYMyJhEiqdC2z5JhuDbxL8ix9UVvjgMu2Er1NepE"
+	)
+	for _, snapshotKeys := range []bool{false, true} {
+		snapshotKeys := snapshotKeys
+		t.Run(fmt.Sprintf("snapshotKeys=%v", snapshotKeys), func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			keyFile := filepath.Join(t.TempDir(), "keys")
+			require.NoError(os.WriteFile(keyFile, []byte(vmrqKey+"\n"), 0o600))
+			pc := NewProcessCreator()
+			snapshotsDir := t.TempDir()
+			nw := newTestNetworkWithConfig(t, pc, snapshotsDir, 1, func(networkConfig *network.Config) {
+				networkConfig.SnapshotKeys = snapshotKeys
+				networkConfig.FundingKeys = []string{ewoqKey}
+				networkConfig.FundingKeyFile = keyFile
+				networkConfig.SubnetOwnerKeys = []string{ewoqKey}
+				networkConfig.ValidatorBond.NodeOwnerKeys = []string{ewoqKey}
+				networkConfig.AdminKey = vmrqKey
+			})
+			ctx, cancel := context.WithTimeout(context.Background(), healthyTimeout)
+			defer cancel()
+			require.NoError(nw.Healthy(ctx))
+
+			snapshotDir, err := nw.SaveSnapshot(ctx, "keys")
+			require.NoError(err)
+			networkConfigPath := filepath.Join(snapshotDir, "network.json")
+			info, err := os.Stat(networkConfigPath)
+			require.NoError(err)
+			require.Equal(os.FileMode(0o600), info.Mode().Perm())
+			networkConfigJSON, err := os.ReadFile(networkConfigPath)
+			require.NoError(err)
+			savedConfig := network.Config{}
+			require.NoError(json.Unmarshal(networkConfigJSON, &savedConfig))
+
+			// the key file is saved by path, the inline keys only on request
+			require.Equal(keyFile, savedConfig.FundingKeyFile)
+			require.Equal(snapshotKeys, savedConfig.SnapshotKeys)
+			if snapshotKeys {
+				require.Equal([]string{ewoqKey}, savedConfig.FundingKeys)
+				require.Equal([]string{ewoqKey}, savedConfig.SubnetOwnerKeys)
+				require.Equal([]string{ewoqKey}, savedConfig.ValidatorBond.NodeOwnerKeys)
+				require.Equal(vmrqKey, savedConfig.AdminKey)
+			} else {
+				require.Empty(savedConfig.FundingKeys)
+				require.Empty(savedConfig.SubnetOwnerKeys)
+				require.Empty(savedConfig.ValidatorBond.NodeOwnerKeys)
+				require.Empty(savedConfig.AdminKey)
+				require.NotContains(string(networkConfigJSON), "PrivateKey-")
+			}
+		})
+	}
+}
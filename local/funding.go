@@ -0,0 +1,84 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// initial admins of the local genesis, on the P-chain and on the C-chain
+var (
+	pChainGenesisAdminKey = genesis.VMRQKey
+	cChainGenesisAdminKey = genesis.EWOQKey
+)
+
+// Returns the keys funding the P-chain txs of [ln].
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) getFundingKeys() []*secp256k1.PrivateKey {
+	if len(ln.fundingKeys) == 0 {
+		return []*secp256k1.PrivateKey{genesis.EWOQKey}
+	}
+	return ln.fundingKeys
+}
+
+// Returns the key holding the admin role of the chain whose genesis admin
+// is [genesisAdminKey], that key unless an admin key is configured.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) getAdminKey(genesisAdminKey *secp256k1.PrivateKey) *secp256k1.PrivateKey {
+	if ln.adminKey == nil {
+		return genesisAdminKey
+	}
+	return ln.adminKey
+}
+
+// Returns the unlocked P-chain balance of the funding keys of [w].
+func (w *wallet) balance(ctx context.Context) (uint64, error) {
+	utxos, err := w.pBackend.UTXOs(ctx, constants.PlatformChainID)
+	if err != nil {
+		return 0, err
+	}
+	kc := secp256k1fx.NewKeychain(w.keys...)
+	assetID := w.pBackend.AVAXAssetID()
+	balance := uint64(0)
+	for _, u := range utxos {
+		// locked outputs are wrapped, so aren't transfer outputs
+		out, ok := u.Out.(*secp256k1fx.TransferOutput)
+		if !ok || u.AssetID() != assetID {
+			continue
+		}
+		if _, _, err := kc.Spend(out, 0); err != nil {
+			continue
+		}
+		if balance, err = math.Add64(balance, out.Amt); err != nil {
+			return 0, err
+		}
+	}
+	return balance, nil
+}
+
+// Returns an error if the funding keys of [w] hold less than [needed]
+// unlocked nAVAX, needed to [action].
+func (w *wallet) checkBalance(ctx context.Context, needed uint64, action string) error {
+	if needed == 0 {
+		return nil
+	}
+	balance, err := w.balance(ctx)
+	if err != nil {
+		return err
+	}
+	if balance < needed {
+		return fmt.Errorf("%w: funding keys hold %d nAVAX, %s needs %d", ErrInsufficientFunds, balance, action, needed)
+	}
+	return nil
+}
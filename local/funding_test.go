@@ -0,0 +1,88 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/p"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFundingKeys(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	ln := &localNetwork{}
+	require.Equal([]*secp256k1.PrivateKey{genesis.EWOQKey}, ln.getFundingKeys())
+	ln.fundingKeys = []*secp256k1.PrivateKey{genesis.VMRQKey}
+	require.Equal([]*secp256k1.PrivateKey{genesis.VMRQKey}, ln.getFundingKeys())
+}
+
+func TestAdminKey(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	ln := &localNetwork{}
+	require.Equal(genesis.VMRQKey, ln.getAdminKey(pChainGenesisAdminKey))
+	require.Equal(genesis.EWOQKey, ln.getAdminKey(cChainGenesisAdminKey))
+	ln.adminKey = genesis.VMRQKey
+	require.Equal(genesis.VMRQKey, ln.getAdminKey(cChainGenesisAdminKey))
+}
+
+func TestCheckBalance(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	ctx := context.Background()
+	avaxAssetID := ids.GenerateTestID()
+	newUTXO := func(assetID ids.ID, out avax.TransferableOut) *avax.UTXO {
+		return &avax.UTXO{
+			UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+			Asset:  avax.Asset{ID: assetID},
+			Out:    out,
+		}
+	}
+	newOut := func(amount uint64, addr ids.ShortID) *secp256k1fx.TransferOutput {
+		return &secp256k1fx.TransferOutput{
+			Amt:          amount,
+			OutputOwners: secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr}},
+		}
+	}
+	ewoqAddr := genesis.EWOQKey.PublicKey().Address()
+	vmrqAddr := genesis.VMRQKey.PublicKey().Address()
+	utxos := primary.NewUTXOs()
+	for _, u := range []*avax.UTXO{
+		newUTXO(avaxAssetID, newOut(100, ewoqAddr)),
+		newUTXO(avaxAssetID, newOut(20, vmrqAddr)),
+		newUTXO(avaxAssetID, newOut(1000, ids.GenerateTestShortID())),
+		newUTXO(ids.GenerateTestID(), newOut(1000, ewoqAddr)),
+		newUTXO(avaxAssetID, &locked.Out{
+			IDs:             locked.IDs{BondTxID: ids.GenerateTestID()},
+			TransferableOut: newOut(1000, ewoqAddr),
+		}),
+	} {
+		require.NoError(utxos.AddUTXO(ctx, constants.PlatformChainID, constants.PlatformChainID, u))
+	}
+	pCTX := p.NewContext(constants.LocalID, avaxAssetID, 0, 0, 0, 0, 0, 0, 0, 0)
+	w := &wallet{
+		keys:     []*secp256k1.PrivateKey{genesis.EWOQKey, genesis.VMRQKey},
+		pBackend: p.NewBackend(pCTX, primary.NewChainUTXOs(constants.PlatformChainID, utxos), nil),
+	}
+
+	balance, err := w.balance(ctx)
+	require.NoError(err)
+	require.Equal(uint64(120), balance)
+	require.NoError(w.checkBalance(ctx, 120, "the test"))
+	require.ErrorIs(w.checkBalance(ctx, 121, "the test"), ErrInsufficientFunds)
+}
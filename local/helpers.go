@@ -1,8 +1,8 @@
 package local
 
 import (
-	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"math"
 	"math/rand"
@@ -26,6 +26,7 @@ const (
 	maxPort          = math.MaxUint16
 	minPort          = 10000
 	netListenTimeout = 3 * time.Second
+	netListenRetry   = 50 * time.Millisecond
 )
 
 // isFreePort verifies a given [port] is free
@@ -41,27 +42,18 @@ func isFreePort(port uint16) bool {
 	return true
 }
 
-// getFreePort generates a random port number and then
-// verifies it is free. If it is, returns that port, otherwise retries.
-// Returns an error if no free port is found within [netListenTimeout].
-// Note that it is possible for [getFreePort] to return the same port twice.
-func getFreePort() (uint16, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), netListenTimeout)
-	defer cancel()
-	for {
-		select {
-		case <-ctx.Done():
-			return 0, ctx.Err()
-		default:
-			// Generate random port in [minPort, maxPort]
-			port := uint16(rand.Intn(maxPort-minPort+1) + minPort) //nolint
-			if !isFreePort(port) {
-				// Not free. Try another.
-				continue
-			}
-			return port, nil
+// waitFreePort returns true once [port] is free, or false if it isn't
+// within [netListenTimeout]. The port of a just stopped node can be held
+// for a moment by its closing connections.
+func waitFreePort(port uint16) bool {
+	deadline := time.Now().Add(netListenTimeout)
+	for !isFreePort(port) {
+		if time.Now().After(deadline) {
+			return false
 		}
+		time.Sleep(netListenRetry)
 	}
+	return true
 }
 
 // writeFiles writes the files a node needs on startup.
@@ -179,13 +171,15 @@ func getConfigEntry(
 	return defaultVal, nil
 }
 
-// getPort looks up the port config in the config file, if there is none, it tries to get a random free port from the OS
-// if [reassingIfUsed] is true, and the port from config is not free, also tries to get a random free port
+// getPort looks up the port config in the config file, if there is none, it reserves a free port of the
+// network range in [ports]. If [reassignIfUsed] is true, and the port from config is not free, also reserves
+// a free port of the range
 func getPort(
 	flags map[string]interface{},
 	configFile map[string]interface{},
 	portKey string,
 	reassignIfUsed bool,
+	ports *portRegistry,
 ) (port uint16, err error) {
 	if portIntf, ok := flags[portKey]; ok {
 		switch gotPort := portIntf.(type) {
@@ -203,22 +197,23 @@ func getPort(
 		}
 		port = uint16(portFromConfigFile)
 	} else {
-		// Use a random free port.
-		// Note: it is possible but unlikely for getFreePort to return the same port multiple times.
-		port, err = getFreePort()
+		port, err = ports.reserveFree()
 		if err != nil {
 			return 0, fmt.Errorf("couldn't get free port: %w", err)
 		}
+		return port, nil
 	}
-	if reassignIfUsed && !isFreePort(port) {
-		port, err = getFreePort()
+	err = ports.reserve(port)
+	if reassignIfUsed && (errors.Is(err, ErrPortReserved) || errors.Is(err, ErrPortNotFree)) {
+		port, err = ports.reserveFree()
 		if err != nil {
 			return 0, fmt.Errorf("couldn't get free port: %w", err)
 		}
+		return port, nil
 	}
-	// last check, avoid starting network with used ports
-	if !isFreePort(port) {
-		return 0, fmt.Errorf("port %d is not free", port)
+	// avoid starting network with used ports
+	if err != nil {
+		return 0, err
 	}
 	return port, nil
 }
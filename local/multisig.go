@@ -0,0 +1,141 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+	"go.uber.org/zap"
+)
+
+// See network.Network
+func (ln *localNetwork) CreateMultisigAlias(
+	ctx context.Context,
+	threshold uint32,
+	addresses []string,
+	memo string,
+) (ids.ID, string, error) {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if ln.stopCalled() {
+		return ids.Empty, "", network.ErrStopped
+	}
+	addrs, err := network.ParseMultisigAlias(threshold, addresses, memo)
+	if err != nil {
+		return ids.Empty, "", err
+	}
+	if ln.getSomeNode() == nil {
+		return ids.Empty, "", errNoRunningNode
+	}
+	clientURI, err := ln.getClientURI()
+	if err != nil {
+		return ids.Empty, "", err
+	}
+	w, err := newWallet(ctx, clientURI, ln.getFundingKeys(), nil)
+	if err != nil {
+		return ids.Empty, "", err
+	}
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	owners := &secp256k1fx.OutputOwners{
+		Threshold: threshold,
+		Addrs:     addrs,
+	}
+	txID, err := w.issueMultisigAliasTx(owners, memo, common.WithContext(cctx), defaultPoll)
+	if err != nil {
+		return ids.Empty, "", fmt.Errorf("P-Wallet Tx Error %s %w", "IssueMultisigAliasTx", err)
+	}
+	aliasID := multisig.ComputeAliasID(txID)
+	alias, err := address.Format("P", constants.GetHRP(ln.networkID), aliasID.Bytes())
+	if err != nil {
+		return ids.Empty, "", err
+	}
+	ln.log.Info("created multisig alias",
+		zap.String("alias", alias),
+		zap.Uint32("threshold", threshold),
+		zap.Strings("owners", addresses),
+		zap.String("tx-ID", txID.String()),
+	)
+	return txID, alias, nil
+}
+
+// Issues a MultisigAliasTx creating an alias owned by [owners].
+// The alias address is derived from the tx ID.
+func (w *wallet) issueMultisigAliasTx(
+	owners *secp256k1fx.OutputOwners,
+	memo string,
+	options ...common.Option,
+) (ids.ID, error) {
+	baseTx, err := w.pCaminoBuilder.NewBaseTx(nil, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	utx := &txs.MultisigAliasTx{
+		BaseTx: baseTx.BaseTx,
+		MultisigAlias: multisig.Alias{
+			Memo:   []byte(memo),
+			Owners: owners,
+		},
+		// new aliases need no owner authorization
+		Auth: &secp256k1fx.Input{},
+	}
+	return w.pWallet.IssueUnsignedTx(utx, options...)
+}
+
+// Adds the keys of the owners of [subnetSpecs] to the keys the subnet txs
+// of the network are signed with.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) addSubnetOwnerKeys(subnetSpecs []network.SubnetSpec) error {
+	known := map[ids.ShortID]struct{}{}
+	for _, key := range ln.subnetOwnerKeys {
+		known[key.PublicKey().Address()] = struct{}{}
+	}
+	for _, subnetSpec := range subnetSpecs {
+		if subnetSpec.Owner == nil {
+			continue
+		}
+		keys, _, err := subnetSpec.Owner.Parse()
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			addr := key.PublicKey().Address()
+			if _, ok := known[addr]; ok {
+				continue
+			}
+			known[addr] = struct{}{}
+			ln.subnetOwnerKeys = append(ln.subnetOwnerKeys, key)
+		}
+	}
+	return nil
+}
+
+// Returns the owner of the subnet of [subnetSpec], [fundingAddr] alone if
+// the spec has none.
+func getSubnetOwner(subnetSpec network.SubnetSpec, fundingAddr ids.ShortID) (*secp256k1fx.OutputOwners, error) {
+	if subnetSpec.Owner == nil {
+		return &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{fundingAddr},
+		}, nil
+	}
+	_, addrs, err := subnetSpec.Owner.Parse()
+	if err != nil {
+		return nil, err
+	}
+	return &secp256k1fx.OutputOwners{
+		Threshold: subnetSpec.Owner.Threshold,
+		Addrs:     addrs,
+	}, nil
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubnetOwnerKeys(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	factory := secp256k1.Factory{}
+	key1, err := factory.NewPrivateKey()
+	require.NoError(err)
+	key2, err := factory.NewPrivateKey()
+	require.NoError(err)
+	specs := []network.SubnetSpec{
+		{},
+		{Owner: &network.SubnetOwner{Threshold: 1, Keys: []string{key1.String()}}},
+		{Owner: &network.SubnetOwner{Threshold: 2, Keys: []string{key2.String(), key1.String()}}},
+	}
+
+	ln := &localNetwork{}
+	require.NoError(ln.addSubnetOwnerKeys(specs))
+	require.Len(ln.subnetOwnerKeys, 2)
+	require.NoError(ln.addSubnetOwnerKeys(specs))
+	require.Len(ln.subnetOwnerKeys, 2)
+
+	fundingAddr := ids.GenerateTestShortID()
+	owner, err := getSubnetOwner(specs[0], fundingAddr)
+	require.NoError(err)
+	require.Equal(uint32(1), owner.Threshold)
+	require.Equal([]ids.ShortID{fundingAddr}, owner.Addrs)
+
+	owner, err = getSubnetOwner(specs[2], fundingAddr)
+	require.NoError(err)
+	require.Equal(uint32(2), owner.Threshold)
+	require.ElementsMatch([]ids.ShortID{key1.PublicKey().Address(), key2.PublicKey().Address()}, owner.Addrs)
+	require.NoError(owner.Verify())
+}
@@ -0,0 +1,157 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"go.uber.org/zap"
+)
+
+const (
+	// Bridge connecting the node namespaces to the host.
+	namespaceBridge           = "anr0"
+	namespaceRegistryFileName = "namespaces.json"
+	namespacePrefixLen        = 16
+)
+
+var (
+	ErrNamespaceIPReserved = errors.New("namespace IP is reserved by another runner")
+	ErrNoFreeNamespaceIP   = errors.New("no free namespace IP")
+	ErrInvalidNamespaceIP  = errors.New("invalid namespace IP")
+
+	// Node IPs are allocated from 10.213.0.0/16.
+	// The bridge has the first address and is the default route of the nodes.
+	namespaceGateway = net.IPv4(10, 213, 0, 1).To4()
+	namespaceSubnet  = &net.IPNet{
+		IP:   net.IPv4(10, 213, 0, 0).To4(),
+		Mask: net.CIDRMask(namespacePrefixLen, 8*net.IPv4len),
+	}
+)
+
+// nodeNamespace is the network namespace a node runs in, connected to
+// [namespaceBridge] by a veth pair, with its own IP.
+type nodeNamespace struct {
+	ip net.IP
+}
+
+// Names are derived from the IP, which is unique on this host.
+func (ns *nodeNamespace) name() string {
+	return fmt.Sprintf("anr-%d-%d", ns.ip[2], ns.ip[3])
+}
+
+// veth end on the bridge
+func (ns *nodeNamespace) hostVeth() string {
+	return fmt.Sprintf("anrh%d-%d", ns.ip[2], ns.ip[3])
+}
+
+// veth end in the namespace
+func (ns *nodeNamespace) nodeVeth() string {
+	return fmt.Sprintf("anrn%d-%d", ns.ip[2], ns.ip[3])
+}
+
+// Returns the command line that runs [binaryPath] with [args] in the namespace.
+func (ns *nodeNamespace) command(binaryPath string, args []string) (string, []string) {
+	return "ip", append([]string{"netns", "exec", ns.name(), binaryPath}, args...)
+}
+
+// reserveNamespaceIP reserves [requested] for [owner] in the host registry
+// in [dir], or a free IP of the namespace subnet if [requested] is nil.
+func reserveNamespaceIP(dir string, owner string, requested net.IP) (net.IP, error) {
+	if requested != nil {
+		requested = requested.To4()
+		if requested == nil || !namespaceSubnet.Contains(requested) || requested.Equal(namespaceGateway) {
+			return nil, fmt.Errorf("%w: %s not in %s", ErrInvalidNamespaceIP, requested, namespaceSubnet)
+		}
+	}
+	var ip net.IP
+	err := updateRegistry(dir, namespaceRegistryFileName, func(entries map[string]reservation) error {
+		if requested != nil {
+			if entry, ok := entries[requested.String()]; ok && entry.Owner != owner {
+				return fmt.Errorf("%w: %s, pid %d", ErrNamespaceIPReserved, requested, entry.PID)
+			}
+			ip = requested
+		} else {
+			// skip network, gateway and broadcast addresses
+			for i := 2; i < 1<<(8*net.IPv4len-namespacePrefixLen)-1; i++ {
+				candidate := net.IPv4(namespaceSubnet.IP[0], namespaceSubnet.IP[1], byte(i>>8), byte(i)).To4()
+				if _, ok := entries[candidate.String()]; !ok {
+					ip = candidate
+					break
+				}
+			}
+			if ip == nil {
+				return fmt.Errorf("%w in %s", ErrNoFreeNamespaceIP, namespaceSubnet)
+			}
+		}
+		entries[ip.String()] = reservation{PID: os.Getpid(), Owner: owner}
+		return nil
+	})
+	return ip, err
+}
+
+// releaseNamespaceIP releases [ip] if reserved by [owner].
+func releaseNamespaceIP(dir string, owner string, ip net.IP) error {
+	return updateRegistry(dir, namespaceRegistryFileName, func(entries map[string]reservation) error {
+		if entry, ok := entries[ip.String()]; ok && entry.Owner == owner {
+			delete(entries, ip.String())
+		}
+		return nil
+	})
+}
+
+// setupNamespace returns the namespace of the node of [nodeConfig], creating
+// it if needed, and points the node's public IP and HTTP host flags to it.
+// The IP is kept from a previous run (e.g. restart or snapshot) if given.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) setupNamespace(nodeConfig *node.Config) (*nodeNamespace, error) {
+	namespace, ok := ln.namespaces[nodeConfig.Name]
+	if !ok {
+		var requested net.IP
+		if ipStr, ok := nodeConfig.Flags[config.PublicIPKey].(string); ok {
+			requested = net.ParseIP(ipStr)
+		}
+		ip, err := reserveNamespaceIP(defaultRegistryDir, ln.rootDir, requested)
+		if err != nil {
+			return nil, err
+		}
+		namespace = &nodeNamespace{ip: ip}
+		if err := namespace.create(); err != nil {
+			_ = releaseNamespaceIP(defaultRegistryDir, ln.rootDir, ip)
+			return nil, err
+		}
+		ln.namespaces[nodeConfig.Name] = namespace
+		ln.log.Info(
+			"created node network namespace",
+			zap.String("node-name", nodeConfig.Name),
+			zap.String("namespace", namespace.name()),
+			zap.Stringer("ip", ip),
+		)
+	}
+	nodeConfig.Flags[config.PublicIPKey] = namespace.ip.String()
+	nodeConfig.Flags[config.HTTPHostKey] = namespace.ip.String()
+	return namespace, nil
+}
+
+// teardownNamespace deletes the namespace of [nodeName], if any.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) teardownNamespace(nodeName string) error {
+	namespace, ok := ln.namespaces[nodeName]
+	if !ok {
+		return nil
+	}
+	delete(ln.namespaces, nodeName)
+	errs := wrappers.Errs{}
+	errs.Add(
+		namespace.destroy(),
+		releaseNamespaceIP(defaultRegistryDir, ln.rootDir, namespace.ip),
+	)
+	return errs.Err
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+var ErrNamespacesRequireRoot = errors.New("node network namespaces require root")
+
+// Runs the ip command with [args].
+func runIP(args ...string) error {
+	out, err := exec.Command("ip", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("ip %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// Creates [namespaceBridge] if no runner did yet.
+func ensureNamespaceBridge() error {
+	if runIP("link", "show", namespaceBridge) != nil {
+		if err := runIP("link", "add", namespaceBridge, "type", "bridge"); err != nil {
+			// another runner may have just created it
+			if runIP("link", "show", namespaceBridge) != nil {
+				return err
+			}
+		}
+	}
+	gateway := &net.IPNet{IP: namespaceGateway, Mask: namespaceSubnet.Mask}
+	if err := runIP("addr", "replace", gateway.String(), "dev", namespaceBridge); err != nil {
+		return err
+	}
+	return runIP("link", "set", namespaceBridge, "up")
+}
+
+// create creates the namespace and connects it to the bridge,
+// replacing a namespace with the same name left by a crashed runner.
+func (ns *nodeNamespace) create() error {
+	if os.Geteuid() != 0 {
+		return ErrNamespacesRequireRoot
+	}
+	if err := ensureNamespaceBridge(); err != nil {
+		return fmt.Errorf("couldn't create bridge %q: %w", namespaceBridge, err)
+	}
+	_ = runIP("netns", "del", ns.name())
+	address := &net.IPNet{IP: ns.ip, Mask: namespaceSubnet.Mask}
+	for _, args := range [][]string{
+		{"netns", "add", ns.name()},
+		{"link", "add", ns.hostVeth(), "type", "veth", "peer", "name", ns.nodeVeth()},
+		{"link", "set", ns.nodeVeth(), "netns", ns.name()},
+		{"link", "set", ns.hostVeth(), "master", namespaceBridge},
+		{"link", "set", ns.hostVeth(), "up"},
+		{"-n", ns.name(), "addr", "add", address.String(), "dev", ns.nodeVeth()},
+		{"-n", ns.name(), "link", "set", ns.nodeVeth(), "up"},
+		{"-n", ns.name(), "link", "set", "lo", "up"},
+		{"-n", ns.name(), "route", "add", "default", "via", namespaceGateway.String()},
+	} {
+		if err := runIP(args...); err != nil {
+			_ = ns.destroy()
+			return fmt.Errorf("couldn't create namespace %q: %w", ns.name(), err)
+		}
+	}
+	return nil
+}
+
+// destroy deletes the namespace, which also deletes its veth pair.
+func (ns *nodeNamespace) destroy() error {
+	err := runIP("netns", "del", ns.name())
+	// the host end is left behind if the namespace creation failed halfway
+	_ = runIP("link", "del", ns.hostVeth())
+	return err
+}
@@ -0,0 +1,18 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+//go:build !linux
+
+package local
+
+import "errors"
+
+var ErrNamespacesUnsupported = errors.New("node network namespaces are only supported on linux")
+
+func (*nodeNamespace) create() error {
+	return ErrNamespacesUnsupported
+}
+
+func (*nodeNamespace) destroy() error {
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"net"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Set to run the tests that create network namespaces on this host.
+const netnsTestEnvVar = "ANR_TEST_NETNS"
+
+func TestReserveNamespaceIP(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	dir := t.TempDir()
+	ip1, err := reserveNamespaceIP(dir, "net1", nil)
+	require.NoError(err)
+	require.True(namespaceSubnet.Contains(ip1))
+	require.False(ip1.Equal(namespaceGateway))
+	ip2, err := reserveNamespaceIP(dir, "net2", nil)
+	require.NoError(err)
+	require.False(ip1.Equal(ip2))
+
+	// a restarted node keeps its IP
+	ip, err := reserveNamespaceIP(dir, "net1", ip1)
+	require.NoError(err)
+	require.True(ip1.Equal(ip))
+	_, err = reserveNamespaceIP(dir, "net1", ip2)
+	require.ErrorIs(err, ErrNamespaceIPReserved)
+	_, err = reserveNamespaceIP(dir, "net1", net.IPv4(127, 0, 0, 1))
+	require.ErrorIs(err, ErrInvalidNamespaceIP)
+
+	require.NoError(releaseNamespaceIP(dir, "net2", ip2))
+	_, err = reserveNamespaceIP(dir, "net1", ip2)
+	require.NoError(err)
+}
+
+func TestNodeNamespace(t *testing.T) {
+	if runtime.GOOS != "linux" || os.Getenv(netnsTestEnvVar) == "" {
+		t.Skipf("set %s to run as root on linux", netnsTestEnvVar)
+	}
+	require := require.New(t)
+
+	ip, err := reserveNamespaceIP(defaultRegistryDir, t.TempDir(), nil)
+	require.NoError(err)
+	ns := &nodeNamespace{ip: ip}
+	require.NoError(ns.create())
+	defer func() {
+		require.NoError(ns.destroy())
+	}()
+
+	binaryPath, args := ns.command("ip", []string{"-4", "-o", "addr", "show", "dev", ns.nodeVeth()})
+	out, err := exec.Command(binaryPath, args...).CombinedOutput()
+	require.NoError(err, string(out))
+	require.Contains(string(out), ip.String()+"/16")
+
+	// the node reaches the host through the bridge
+	l, err := net.Listen("tcp", net.JoinHostPort(namespaceGateway.String(), "0"))
+	require.NoError(err)
+	defer l.Close()
+	binaryPath, args = ns.command("bash", []string{"-c", "echo hi > /dev/tcp/" + strings.ReplaceAll(l.Addr().String(), ":", "/")})
+	out, err = exec.Command(binaryPath, args...).CombinedOutput()
+	require.NoError(err, string(out))
+	conn, err := l.Accept()
+	require.NoError(err)
+	require.Equal(ip.String(), conn.RemoteAddr().(*net.TCPAddr).IP.String())
+	require.NoError(conn.Close())
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/ava-labs/avalanche-network-runner/network/node"
 	"github.com/ava-labs/avalanche-network-runner/network/node/status"
 	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanche-network-runner/utils/fastcopy"
 	"github.com/ava-labs/avalanchego/config"
 	"github.com/ava-labs/avalanchego/genesis"
 	"github.com/ava-labs/avalanchego/network/peer"
@@ -29,6 +30,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/beacon"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/ips"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/set"
@@ -115,6 +117,33 @@ type localNetwork struct {
 	subnetConfigFiles map[string]string
 	// if true, for ports given in conf that are already taken, assign new random ones
 	reassignPortsIfUsed bool
+	// node ports reserved by this network
+	ports *portRegistry
+	// if true, each node runs in its own network namespace
+	nodeNamespaces bool
+	// Node Name --> network namespace of the node, kept while the node is paused
+	namespaces map[string]*nodeNamespace
+	// if true, snapshots are saved to the deduplicated snapshot store
+	dedupSnapshots bool
+	// if true, the private keys given inline in the config are saved in snapshots
+	snapshotKeys bool
+	// Node Name --> how its db dir was copied by the last snapshot save or load
+	dbCopies map[string]fastcopy.Result
+	// bond of the nodes added as primary network validators
+	validatorBond network.ValidatorBond
+	// keys of the owners of subnets created by the runner
+	subnetOwnerKeys []*secp256k1.PrivateKey
+	// keys funding the P-chain txs of the runner, the EWOQ key if nil
+	fundingKeys []*secp256k1.PrivateKey
+	// funding keys given inline in the config, and file with further ones
+	inlineFundingKeys []string
+	fundingKeyFile    string
+	// key holding the admin role of the P-chain and the C-chain,
+	// nil for the initial admins of the local genesis
+	adminKey *secp256k1.PrivateKey
+	// peers of the external network joined, nil if the network is owned
+	// by the runner
+	externalPeers []node.BootstrapPeer
 }
 
 type deprecatedFlagEsp struct {
@@ -277,15 +306,34 @@ func NewNetwork(
 	snapshotsDir string,
 	reassignPortsIfUsed bool,
 ) (network.Network, error) {
+	return NewNetworkWithNodeProcessCreator(
+		log,
+		networkConfig,
+		rootDir,
+		snapshotsDir,
+		reassignPortsIfUsed,
+		nil,
+	)
+}
+
+// NewNetworkWithNodeProcessCreator is like NewNetwork, but node processes
+// are launched by [nodeProcessCreator] (e.g. a fake backend in tests).
+// If [nodeProcessCreator] is nil, camino-node binaries are executed.
+func NewNetworkWithNodeProcessCreator(
+	log logging.Logger,
+	networkConfig network.Config,
+	rootDir string,
+	snapshotsDir string,
+	reassignPortsIfUsed bool,
+	nodeProcessCreator NodeProcessCreator,
+) (network.Network, error) {
+	if nodeProcessCreator == nil {
+		nodeProcessCreator = newDefaultNodeProcessCreator(log)
+	}
 	net, err := newNetwork(
 		log,
 		api.NewAPIClient,
-		&nodeProcessCreator{
-			colorPicker: utils.NewColorPicker(),
-			log:         log,
-			stdout:      os.Stdout,
-			stderr:      os.Stderr,
-		},
+		nodeProcessCreator,
 		rootDir,
 		snapshotsDir,
 		reassignPortsIfUsed,
@@ -335,6 +383,8 @@ func newNetwork(
 		rootDir:             rootDir,
 		snapshotsDir:        snapshotsDir,
 		reassignPortsIfUsed: reassignPortsIfUsed,
+		ports:               newPortRegistry(defaultRegistryDir, rootDir),
+		namespaces:          map[string]*nodeNamespace{},
 	}
 	return net, nil
 }
@@ -383,6 +433,37 @@ func NewDefaultConfig(binaryPath string) network.Config {
 	return config
 }
 
+// NewExternalNetworkConfig creates a config of [numNodes] nodes joining the
+// external network of [genesis] by bootstrapping from [peers].
+// The nodes get new staking keys and dynamic ports.
+func NewExternalNetworkConfig(binaryPath string, genesis string, numNodes uint32, peers []node.BootstrapPeer) (network.Config, error) {
+	config := network.Config{
+		Genesis:            genesis,
+		NodeConfigs:        make([]node.Config, numNodes),
+		Flags:              maps.Clone(defaultNetworkConfig.Flags),
+		BinaryPath:         binaryPath,
+		ChainConfigFiles:   maps.Clone(defaultNetworkConfig.ChainConfigFiles),
+		UpgradeConfigFiles: map[string]string{},
+		SubnetConfigFiles:  map[string]string{},
+		ExternalPeers:      peers,
+	}
+	for i := range config.NodeConfigs {
+		stakingCert, stakingKey, err := staking.NewCertAndKeyBytes()
+		if err != nil {
+			return config, fmt.Errorf("couldn't generate staking Cert/Key: %w", err)
+		}
+		config.NodeConfigs[i] = node.Config{
+			Flags:              map[string]interface{}{},
+			ChainConfigFiles:   map[string]string{},
+			UpgradeConfigFiles: map[string]string{},
+			SubnetConfigFiles:  map[string]string{},
+			StakingKey:         string(stakingKey),
+			StakingCert:        string(stakingCert),
+		}
+	}
+	return config, nil
+}
+
 // NewDefaultConfigNNodes creates a new default network config, with an arbitrary number of nodes
 func NewDefaultConfigNNodes(binaryPath string, numNodes uint32) (network.Config, error) {
 	netConfig := NewDefaultConfig(binaryPath)
@@ -433,6 +514,10 @@ func (ln *localNetwork) loadConfig(ctx context.Context, networkConfig network.Co
 	}
 	ln.log.Info("creating network", zap.Int("node-num", len(networkConfig.NodeConfigs)))
 
+	// schedule offsets are relative to the network start
+	if err := networkConfig.ApplyUpgradeSchedule(time.Now()); err != nil {
+		return err
+	}
 	ln.genesis = []byte(networkConfig.Genesis)
 
 	var err error
@@ -456,20 +541,48 @@ func (ln *localNetwork) loadConfig(ctx context.Context, networkConfig network.Co
 	if ln.subnetConfigFiles == nil {
 		ln.subnetConfigFiles = map[string]string{}
 	}
-
-	// Sort node configs so beacons start first
-	var nodeConfigs []node.Config
-	for _, nodeConfig := range networkConfig.NodeConfigs {
-		if nodeConfig.IsBeacon {
-			nodeConfigs = append(nodeConfigs, nodeConfig)
+	ln.ports.portRange = networkConfig.PortRange
+	ln.nodeNamespaces = networkConfig.NodeNamespaces
+	ln.dedupSnapshots = networkConfig.DedupSnapshots
+	ln.snapshotKeys = networkConfig.SnapshotKeys
+	ln.validatorBond = networkConfig.ValidatorBond
+	ln.subnetOwnerKeys = nil
+	for _, keyStr := range networkConfig.SubnetOwnerKeys {
+		key, err := utils.ParsePrivateKey(keyStr)
+		if err != nil {
+			return err
 		}
+		ln.subnetOwnerKeys = append(ln.subnetOwnerKeys, key)
 	}
-	for _, nodeConfig := range networkConfig.NodeConfigs {
-		if !nodeConfig.IsBeacon {
-			nodeConfigs = append(nodeConfigs, nodeConfig)
+	fundingKeys, err := networkConfig.GetFundingKeys()
+	if err != nil {
+		return err
+	}
+	ln.fundingKeys = fundingKeys
+	ln.inlineFundingKeys = networkConfig.FundingKeys
+	ln.fundingKeyFile = networkConfig.FundingKeyFile
+	adminKey, err := networkConfig.GetAdminKey()
+	if err != nil {
+		return err
+	}
+	ln.adminKey = adminKey
+	ln.externalPeers = networkConfig.ExternalPeers
+	for _, peer := range ln.externalPeers {
+		nodeID, ip, err := peer.Parse()
+		if err != nil {
+			return err
+		}
+		if err := ln.bootstraps.Add(beacon.New(nodeID, ip)); err != nil {
+			return fmt.Errorf("external peer %s: %w", peer.ID, err)
 		}
 	}
 
+	nodeConfigs := sortNodeConfigs(networkConfig.NodeConfigs)
+
+	if err := ln.validateNetworkNodeFlags(nodeConfigs); err != nil {
+		return err
+	}
+
 	for _, nodeConfig := range nodeConfigs {
 		if _, err := ln.addNode(nodeConfig); err != nil {
 			if err := ln.stop(ctx); err != nil {
@@ -483,6 +596,46 @@ func (ln *localNetwork) loadConfig(ctx context.Context, networkConfig network.Co
 	return nil
 }
 
+// Returns [nodeConfigs] sorted so beacons start first, and nodes start after
+// their bootstrap nodes. Nodes whose bootstrap nodes can't start before them
+// are left last.
+func sortNodeConfigs(nodeConfigs []node.Config) []node.Config {
+	var sorted []node.Config
+	started := set.Set[string]{}
+	for _, nodeConfig := range nodeConfigs {
+		if nodeConfig.IsBeacon {
+			sorted = append(sorted, nodeConfig)
+			started.Add(nodeConfig.Name)
+		}
+	}
+	pending := []node.Config{}
+	for _, nodeConfig := range nodeConfigs {
+		if !nodeConfig.IsBeacon {
+			pending = append(pending, nodeConfig)
+		}
+	}
+	for len(pending) > 0 {
+		remaining := []node.Config{}
+		for _, nodeConfig := range pending {
+			canStart := true
+			for _, nodeName := range nodeConfig.BootstrapNodes {
+				canStart = canStart && started.Contains(nodeName)
+			}
+			if !canStart {
+				remaining = append(remaining, nodeConfig)
+				continue
+			}
+			sorted = append(sorted, nodeConfig)
+			started.Add(nodeConfig.Name)
+		}
+		if len(remaining) == len(pending) {
+			return append(sorted, remaining...)
+		}
+		pending = remaining
+	}
+	return sorted
+}
+
 // See network.Network
 func (ln *localNetwork) AddNode(nodeConfig node.Config) (node.Node, error) {
 	ln.lock.Lock()
@@ -533,6 +686,21 @@ func (ln *localNetwork) addNode(nodeConfig node.Config) (node.Node, error) {
 		}
 	}
 	addNetworkFlags(ln.log, ln.flags, nodeConfig.Flags)
+	if err := nodeConfig.Role.Validate(); err != nil {
+		return nil, err
+	}
+	if err := applyNodeRole(&nodeConfig); err != nil {
+		return nil, err
+	}
+
+	// Get node version
+	nodeSemVer, err := ln.getNodeSemVer(nodeConfig)
+	if err != nil {
+		return nil, err
+	}
+	if err := ln.validateNodeFlags(nodeConfig, nodeSemVer); err != nil {
+		return nil, err
+	}
 
 	// it shouldn't happen that just one is empty, most probably both,
 	// but in any case if just one is empty it's unusable so we just assign a new one.
@@ -560,6 +728,22 @@ func (ln *localNetwork) addNode(nodeConfig node.Config) (node.Node, error) {
 
 	isPausedNode := ln.isPausedNode(&nodeConfig)
 
+	var namespace *nodeNamespace
+	if ln.nodeNamespaces {
+		var err error
+		namespace, err = ln.setupNamespace(&nodeConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Delete the namespace if the node process isn't created
+	processCreated := false
+	defer func() {
+		if !processCreated {
+			_ = ln.teardownNamespace(nodeConfig.Name)
+		}
+	}()
+
 	nodeDir, err := makeNodeDir(ln.log, ln.rootDir, nodeConfig.Name)
 	if err != nil {
 		return nil, err
@@ -573,31 +757,36 @@ func (ln *localNetwork) addNode(nodeConfig node.Config) (node.Node, error) {
 		}
 	}
 
-	// Get node version
-	nodeSemVer, err := ln.getNodeSemVer(nodeConfig)
-	if err != nil {
-		return nil, err
-	}
-
 	nodeData, err := ln.buildArgs(nodeSemVer, configFile, nodeDir, &nodeConfig)
 	if err != nil {
 		return nil, err
 	}
+	processConfig, args := nodeConfig, nodeData.args
+	host := "localhost"
+	beaconIP := net.IPv6loopback
+	if namespace != nil {
+		processConfig.BinaryPath, args = namespace.command(nodeConfig.BinaryPath, args)
+		host = namespace.ip.String()
+		beaconIP = namespace.ip
+	}
 
 	// Parse this node's ID
 	nodeID, err := utils.ToNodeID([]byte(nodeConfig.StakingKey), []byte(nodeConfig.StakingCert))
 	if err != nil {
+		_ = ln.ports.release(nodeData.apiPort, nodeData.p2pPort)
 		return nil, fmt.Errorf("couldn't get node ID: %w", err)
 	}
 
 	// Start the camino node and pass it the flags defined above
-	nodeProcess, err := ln.nodeProcessCreator.NewNodeProcess(nodeConfig, nodeData.args...)
+	nodeProcess, err := ln.nodeProcessCreator.NewNodeProcess(processConfig, args...)
 	if err != nil {
+		_ = ln.ports.release(nodeData.apiPort, nodeData.p2pPort)
 		return nil, fmt.Errorf(
 			"couldn't create new node process with binary %q and args %v: %w",
-			nodeConfig.BinaryPath, nodeData.args, err,
+			processConfig.BinaryPath, args, err,
 		)
 	}
+	processCreated = true
 
 	ln.log.Info(
 		"adding node",
@@ -613,26 +802,30 @@ func (ln *localNetwork) addNode(nodeConfig node.Config) (node.Node, error) {
 		"starting node",
 		zap.String("name", nodeConfig.Name),
 		zap.String("binaryPath", nodeConfig.BinaryPath),
-		zap.Strings("args", nodeData.args),
+		zap.Strings("args", args),
 	)
 
 	// Create a wrapper for this node so we can reference it later
 	node := &localNode{
-		name:          nodeConfig.Name,
-		nodeID:        nodeID,
-		networkID:     ln.networkID,
-		client:        ln.newAPIClientF("localhost", nodeData.apiPort),
-		process:       nodeProcess,
-		apiPort:       nodeData.apiPort,
-		p2pPort:       nodeData.p2pPort,
-		getConnFunc:   defaultGetConnFunc,
-		dataDir:       nodeData.dataDir,
-		dbDir:         nodeData.dbDir,
-		logsDir:       nodeData.logsDir,
-		config:        nodeConfig,
-		pluginDir:     nodeData.pluginDir,
-		httpHost:      nodeData.httpHost,
-		attachedPeers: map[string]peer.Peer{},
+		name:           nodeConfig.Name,
+		nodeID:         nodeID,
+		networkID:      ln.networkID,
+		client:         ln.newAPIClientF(host, nodeData.apiPort),
+		process:        nodeProcess,
+		apiPort:        nodeData.apiPort,
+		p2pPort:        nodeData.p2pPort,
+		getConnFunc:    defaultGetConnFunc,
+		dataDir:        nodeData.dataDir,
+		dbDir:          nodeData.dbDir,
+		logsDir:        nodeData.logsDir,
+		config:         nodeConfig,
+		pluginDir:      nodeData.pluginDir,
+		httpHost:       nodeData.httpHost,
+		attachedPeers:  map[string]peer.Peer{},
+		bootstrapPeers: nodeData.bootstrapPeers,
+	}
+	if namespace != nil {
+		node.ip = namespace.ip.String()
 	}
 	ln.nodes[node.name] = node
 	// If this node is a beacon, add its IP/ID to the beacon lists.
@@ -640,7 +833,7 @@ func (ln *localNetwork) addNode(nodeConfig node.Config) (node.Node, error) {
 	// so this node won't try to use itself as a beacon.
 	if !isPausedNode && nodeConfig.IsBeacon {
 		err = ln.bootstraps.Add(beacon.New(nodeID, ips.IPPort{
-			IP:   net.IPv6loopback,
+			IP:   beaconIP,
 			Port: nodeData.p2pPort,
 		}))
 	}
@@ -739,6 +932,36 @@ func (ln *localNetwork) GetNodeNames() ([]string, error) {
 	return maps.Keys(ln.nodes), nil
 }
 
+// See network.Network
+func (ln *localNetwork) GetUpgrades() ([]network.Upgrade, error) {
+	ln.lock.RLock()
+	defer ln.lock.RUnlock()
+
+	if ln.stopCalled() {
+		return nil, network.ErrStopped
+	}
+	return network.GetUpgrades(ln.genesis)
+}
+
+// See network.Network
+func (ln *localNetwork) GetExternalPeers() ([]node.BootstrapPeer, error) {
+	ln.lock.RLock()
+	defer ln.lock.RUnlock()
+
+	if ln.stopCalled() {
+		return nil, network.ErrStopped
+	}
+	return ln.externalPeers, nil
+}
+
+// See network.Network
+func (ln *localNetwork) GetSnapshotDBCopies() map[string]fastcopy.Result {
+	ln.lock.RLock()
+	defer ln.lock.RUnlock()
+
+	return maps.Clone(ln.dbCopies)
+}
+
 // See network.Network
 func (ln *localNetwork) GetAllNodes() (map[string]node.Node, error) {
 	ln.lock.RLock()
@@ -781,6 +1004,17 @@ func (ln *localNetwork) stop(ctx context.Context) error {
 		}
 		stopCtxCancel()
 	}
+	// also releases ports and namespaces of nodes that failed to be added
+	if err := ln.ports.releaseAll(); err != nil {
+		ln.log.Error("error releasing ports", zap.Error(err))
+		errs.Add(err)
+	}
+	for nodeName := range ln.namespaces {
+		if err := ln.teardownNamespace(nodeName); err != nil {
+			ln.log.Error("error deleting node namespace", zap.String("name", nodeName), zap.Error(err))
+			errs.Add(err)
+		}
+	}
 	ln.log.Info("done stopping network")
 	return errs.Err
 }
@@ -815,10 +1049,16 @@ func (ln *localNetwork) removeNode(ctx context.Context, nodeName string) error {
 		// to avoid errors logs at client
 		node.client.CChainEthAPI().Close()
 		if exitCode := node.process.Stop(ctx); exitCode != 0 {
+			_ = ln.ports.release(node.apiPort, node.p2pPort)
+			_ = ln.teardownNamespace(nodeName)
 			return fmt.Errorf("node %q exited with exit code: %d", nodeName, exitCode)
 		}
 	}
-	return nil
+	if err := ln.teardownNamespace(nodeName); err != nil {
+		_ = ln.ports.release(node.apiPort, node.p2pPort)
+		return err
+	}
+	return ln.ports.release(node.apiPort, node.p2pPort)
 }
 
 // Sends a SIGTERM to the given node and keeps it in the network with paused state
@@ -1023,6 +1263,9 @@ type buildArgsReturn struct {
 	logsDir   string
 	pluginDir string
 	httpHost  string
+
+	// peers given in the bootstrap flags
+	bootstrapPeers []node.BootstrapPeer
 }
 
 // buildArgs returns the:
@@ -1069,15 +1312,26 @@ func (ln *localNetwork) buildArgs(
 	}
 
 	// Use random free API port unless given in config file
-	apiPort, err := getPort(nodeConfig.Flags, configFile, config.HTTPPortKey, ln.reassignPortsIfUsed)
+	apiPort, err := getPort(nodeConfig.Flags, configFile, config.HTTPPortKey, ln.reassignPortsIfUsed, ln.ports)
 	if err != nil {
 		return buildArgsReturn{}, err
 	}
 
 	// Use a random free P2P (staking) port unless given in config file
-	// Use random free API port unless given in config file
-	p2pPort, err := getPort(nodeConfig.Flags, configFile, config.StakingPortKey, ln.reassignPortsIfUsed)
+	p2pPort, err := getPort(nodeConfig.Flags, configFile, config.StakingPortKey, ln.reassignPortsIfUsed, ln.ports)
+	if err != nil {
+		_ = ln.ports.release(apiPort)
+		return buildArgsReturn{}, err
+	}
+
+	bootstraps, err := ln.getBootstraps(nodeConfig)
+	if err != nil {
+		_ = ln.ports.release(apiPort, p2pPort)
+		return buildArgsReturn{}, err
+	}
+	bootstrapPeers, err := ln.getBootstrapPeers(bootstraps)
 	if err != nil {
+		_ = ln.ports.release(apiPort, p2pPort)
 		return buildArgsReturn{}, err
 	}
 
@@ -1089,14 +1343,15 @@ func (ln *localNetwork) buildArgs(
 		config.LogsDirKey:      logsDir,
 		config.HTTPPortKey:     fmt.Sprintf("%d", apiPort),
 		config.StakingPortKey:  fmt.Sprintf("%d", p2pPort),
-		config.BootstrapIPsKey: ln.bootstraps.IPsArg(),
-		config.BootstrapIDsKey: ln.bootstraps.IDsArg(),
+		config.BootstrapIPsKey: bootstraps.IPsArg(),
+		config.BootstrapIDsKey: bootstraps.IDsArg(),
 	}
 
 	// Write staking key/cert etc. to disk so the new node can use them,
 	// and get flag that point the node to those files
 	fileFlags, err := writeFiles(ln.networkID, ln.genesis, dataDir, nodeConfig)
 	if err != nil {
+		_ = ln.ports.release(apiPort, p2pPort)
 		return buildArgsReturn{}, err
 	}
 	for k := range fileFlags {
@@ -1132,17 +1387,82 @@ func (ln *localNetwork) buildArgs(
 	}
 
 	return buildArgsReturn{
-		args:      args,
-		apiPort:   apiPort,
-		p2pPort:   p2pPort,
-		dataDir:   dataDir,
-		dbDir:     dbDir,
-		logsDir:   logsDir,
-		pluginDir: pluginDir,
-		httpHost:  httpHost,
+		args:           args,
+		apiPort:        apiPort,
+		p2pPort:        p2pPort,
+		dataDir:        dataDir,
+		dbDir:          dbDir,
+		logsDir:        logsDir,
+		pluginDir:      pluginDir,
+		httpHost:       httpHost,
+		bootstrapPeers: bootstrapPeers,
 	}, nil
 }
 
+// Returns the beacons [nodeConfig] bootstraps from: its bootstrap nodes and
+// peers if given, or else the beacons of the network.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) getBootstraps(nodeConfig *node.Config) (beacon.Set, error) {
+	if len(nodeConfig.BootstrapNodes) == 0 && len(nodeConfig.BootstrapPeers) == 0 {
+		return ln.bootstraps, nil
+	}
+	bootstraps := beacon.NewSet()
+	for _, nodeName := range nodeConfig.BootstrapNodes {
+		bootstrapNode, ok := ln.nodes[nodeName]
+		if !ok || bootstrapNode.paused {
+			return nil, fmt.Errorf("bootstrap node %q of node %q isn't running", nodeName, nodeConfig.Name)
+		}
+		ip := net.IPv6loopback
+		if bootstrapNode.ip != "" {
+			ip = net.ParseIP(bootstrapNode.ip)
+		}
+		if err := bootstraps.Add(beacon.New(bootstrapNode.nodeID, ips.IPPort{
+			IP:   ip,
+			Port: bootstrapNode.p2pPort,
+		})); err != nil {
+			return nil, fmt.Errorf("bootstrap node %q of node %q: %w", nodeName, nodeConfig.Name, err)
+		}
+	}
+	for _, peer := range nodeConfig.BootstrapPeers {
+		nodeID, ip, err := peer.Parse()
+		if err != nil {
+			return nil, err
+		}
+		if err := bootstraps.Add(beacon.New(nodeID, ip)); err != nil {
+			return nil, fmt.Errorf("bootstrap peer %s of node %q: %w", peer.ID, nodeConfig.Name, err)
+		}
+	}
+	return bootstraps, nil
+}
+
+// Returns the peers of [bootstraps], named after the nodes of the network
+// they are.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) getBootstrapPeers(bootstraps beacon.Set) ([]node.BootstrapPeer, error) {
+	if bootstraps.Len() == 0 {
+		return nil, nil
+	}
+	// the args list the beacons in the same order
+	nodeIDs := strings.Split(bootstraps.IDsArg(), ",")
+	nodeIPs := strings.Split(bootstraps.IPsArg(), ",")
+	if len(nodeIDs) != len(nodeIPs) {
+		return nil, fmt.Errorf("%d bootstrap IDs for %d bootstrap IPs", len(nodeIDs), len(nodeIPs))
+	}
+	names := make(map[string]string, len(ln.nodes))
+	for nodeName, node := range ln.nodes {
+		names[node.nodeID.String()] = nodeName
+	}
+	peers := make([]node.BootstrapPeer, len(nodeIDs))
+	for i, nodeID := range nodeIDs {
+		peers[i] = node.BootstrapPeer{
+			Name: names[nodeID],
+			ID:   nodeID,
+			IP:   nodeIPs[i],
+		}
+	}
+	return peers, nil
+}
+
 // Get AvalancheGo version
 func (ln *localNetwork) getNodeSemVer(nodeConfig node.Config) (string, error) {
 	nodeVersionOutput, err := ln.nodeProcessCreator.GetNodeVersion(nodeConfig)
@@ -26,6 +26,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
+	"github.com/ava-labs/avalanchego/utils/beacon"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/rpc"
 	"github.com/stretchr/testify/mock"
@@ -1084,6 +1085,7 @@ func TestGetConfigEntry(t *testing.T) {
 func TestGetPort(t *testing.T) {
 	t.Parallel()
 	require := require.New(t)
+	ports := newPortRegistry(t.TempDir(), "test")
 
 	// Case: port key present in config file
 	port, err := getPort(
@@ -1091,6 +1093,7 @@ func TestGetPort(t *testing.T) {
 		map[string]interface{}{"flag": float64(10013)},
 		"flag",
 		false,
+		ports,
 	)
 	require.NoError(err)
 	require.Equal(uint16(10013), port)
@@ -1101,6 +1104,7 @@ func TestGetPort(t *testing.T) {
 		map[string]interface{}{},
 		"flag",
 		false,
+		ports,
 	)
 	require.NoError(err)
 	require.Equal(uint16(10013), port)
@@ -1111,18 +1115,21 @@ func TestGetPort(t *testing.T) {
 		map[string]interface{}{"flag": float64(14)},
 		"flag",
 		false,
+		ports,
 	)
 	require.NoError(err)
 	require.Equal(uint16(10013), port)
 
 	// Case: port key not present
-	_, err = getPort(
+	port, err = getPort(
 		map[string]interface{}{},
 		map[string]interface{}{},
 		"flag",
 		false,
+		ports,
 	)
 	require.NoError(err)
+	require.True(ports.reserved.Contains(port))
 }
 
 func TestCreateFileAndWrite(t *testing.T) {
@@ -1346,3 +1353,57 @@ func TestHealthyDuringNetworkStop(t *testing.T) {
 		require.Fail("Healthy should've returned immediately because network closed")
 	}
 }
+
+func TestSortNodeConfigs(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	nodeConfigs := []node.Config{
+		{Name: "node1", BootstrapNodes: []string{"node2"}},
+		{Name: "node2", BootstrapNodes: []string{"node3"}},
+		{Name: "node3", IsBeacon: true},
+		{Name: "node4"},
+		{Name: "node5", BootstrapNodes: []string{"node6"}},
+	}
+	var names []string
+	for _, nodeConfig := range sortNodeConfigs(nodeConfigs) {
+		names = append(names, nodeConfig.Name)
+	}
+	require.Equal([]string{"node3", "node2", "node4", "node1", "node5"}, names)
+}
+
+func TestGetBootstraps(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	node1ID, node2ID, peerID := ids.GenerateTestNodeID(), ids.GenerateTestNodeID(), ids.GenerateTestNodeID()
+	ln := &localNetwork{
+		nodes: map[string]*localNode{
+			"node1": {name: "node1", nodeID: node1ID, p2pPort: 9651},
+			"node2": {name: "node2", nodeID: node2ID, p2pPort: 9653, paused: true},
+		},
+		bootstraps: beacon.NewSet(),
+	}
+
+	bootstraps, err := ln.getBootstraps(&node.Config{Name: "node3"})
+	require.NoError(err)
+	require.Equal(ln.bootstraps, bootstraps)
+
+	_, err = ln.getBootstraps(&node.Config{Name: "node3", BootstrapNodes: []string{"node2"}})
+	require.ErrorContains(err, "isn't running")
+	_, err = ln.getBootstraps(&node.Config{Name: "node3", BootstrapPeers: []node.BootstrapPeer{{ID: peerID.String(), IP: "foo"}}})
+	require.ErrorContains(err, "invalid bootstrap peer IP")
+
+	bootstraps, err = ln.getBootstraps(&node.Config{
+		Name:           "node3",
+		BootstrapNodes: []string{"node1"},
+		BootstrapPeers: []node.BootstrapPeer{{ID: peerID.String(), IP: "10.0.0.1:9651"}},
+	})
+	require.NoError(err)
+	peers, err := ln.getBootstrapPeers(bootstraps)
+	require.NoError(err)
+	require.ElementsMatch([]node.BootstrapPeer{
+		{Name: "node1", ID: node1ID.String(), IP: "[::1]:9651"},
+		{ID: peerID.String(), IP: "10.0.0.1:9651"},
+	}, peers)
+}
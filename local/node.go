@@ -73,11 +73,15 @@ type localNode struct {
 	config node.Config
 	// The node httpHost
 	httpHost string
+	// IP of the node's network namespace, empty if the node runs in the host namespace
+	ip string
 	// maps from peer ID to peer object
 	attachedPeers map[string]peer.Peer
 	// signals that the process is stopped but the information is valid
 	// and can be resumed
 	paused bool
+	// peers the node was started to bootstrap from
+	bootstrapPeers []node.BootstrapPeer
 }
 
 func defaultGetConnFunc(ctx context.Context, node node.Node) (net.Conn, error) {
@@ -202,6 +206,9 @@ func (node *localNode) GetAPIClient() api.Client {
 
 // See node.Node
 func (node *localNode) GetURL() string {
+	if node.ip != "" {
+		return node.ip
+	}
 	if node.httpHost == "0.0.0.0" || node.httpHost == "." {
 		return "0.0.0.0"
 	}
@@ -289,3 +296,8 @@ func (node *localNode) GetFlag(k string) (string, error) {
 func (node *localNode) GetPaused() bool {
 	return node.paused
 }
+
+// See node.Node
+func (node *localNode) GetBootstrapPeers() []node.BootstrapPeer {
+	return node.bootstrapPeers
+}
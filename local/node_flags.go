@@ -0,0 +1,281 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
+)
+
+// max edit distance of a flag name suggested for an unknown flag
+const maxFlagSuggestionDistance = 3
+
+var (
+	ErrUnknownNodeFlag      = errors.New("unknown node flag")
+	ErrInvalidNodeFlagValue = errors.New("invalid node flag value")
+
+	// flag line of a pflag or go flag usage listing, with an optional value type
+	flagUsageRe = regexp.MustCompile(`^ *(?:-\w, )?--?([A-Za-z0-9][\w.-]*)(?: (\w+))?(?:\s{2,}|\s*$)`)
+
+	// Binary hash --> flags supported by the binary
+	nodeFlagsCache     = map[string]*nodeFlags{}
+	nodeFlagsCacheLock sync.Mutex
+	// Binary path, size and modification time --> binary hash,
+	// to not hash unchanged binaries again
+	binaryHashCache = map[binaryFileInfo]string{}
+)
+
+// NodeFlagsLister is implemented by a NodeProcessCreator that can list the
+// flags supported by a node binary. Node flags are validated before launch
+// only if the network's creator implements it.
+type NodeFlagsLister interface {
+	// Returns the flag usage listing of the binary, as printed by --help
+	GetNodeFlags(config node.Config) (string, error)
+}
+
+type binaryFileInfo struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+// Flags supported by a node binary.
+type nodeFlags struct {
+	version string
+	// Flag name --> value type as listed in the usage, empty for bool flags
+	types map[string]string
+}
+
+// validateNodeFlags returns an error if the flags of [nodeConfig] are not
+// supported by its binary, of version [nodeSemVer].
+// The network flags must already be added to [nodeConfig].
+func (ln *localNetwork) validateNodeFlags(nodeConfig node.Config, nodeSemVer string) error {
+	nodeFlags, err := ln.getNodeFlags(nodeConfig, nodeSemVer)
+	if err != nil || nodeFlags == nil {
+		return err
+	}
+	flags := map[string]string{}
+	for flagName, flagVal := range nodeConfig.Flags {
+		// same as the args given to the node
+		flags[flagName] = fmt.Sprintf("%v", flagVal)
+	}
+	return nodeFlags.validate(getFlagsForAvagoVersion(nodeSemVer, flags))
+}
+
+// validateNetworkNodeFlags validates the flags of [nodeConfigs] with the
+// network defaults, so that no node is started if one of them is invalid.
+func (ln *localNetwork) validateNetworkNodeFlags(nodeConfigs []node.Config) error {
+	if _, ok := ln.nodeProcessCreator.(NodeFlagsLister); !ok {
+		return nil
+	}
+	for _, nodeConfig := range nodeConfigs {
+		nodeConfig.Flags = maps.Clone(nodeConfig.Flags)
+		if nodeConfig.Flags == nil {
+			nodeConfig.Flags = map[string]interface{}{}
+		}
+		if nodeConfig.BinaryPath == "" {
+			nodeConfig.BinaryPath = ln.binaryPath
+		}
+		addNetworkFlags(ln.log, ln.flags, nodeConfig.Flags)
+		nodeSemVer, err := ln.getNodeSemVer(nodeConfig)
+		if err != nil {
+			return err
+		}
+		if err := ln.validateNodeFlags(nodeConfig, nodeSemVer); err != nil {
+			return fmt.Errorf("invalid flags for node %q: %w", nodeConfig.Name, err)
+		}
+	}
+	return nil
+}
+
+// Returns the flags supported by the binary of [nodeConfig], nil if the
+// binary doesn't list them.
+func (ln *localNetwork) getNodeFlags(nodeConfig node.Config, nodeSemVer string) (*nodeFlags, error) {
+	lister, ok := ln.nodeProcessCreator.(NodeFlagsLister)
+	if !ok {
+		return nil, nil
+	}
+	hash, err := hashBinary(nodeConfig.BinaryPath)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't hash binary %q: %w", nodeConfig.BinaryPath, err)
+	}
+
+	nodeFlagsCacheLock.Lock()
+	defer nodeFlagsCacheLock.Unlock()
+
+	if flags, ok := nodeFlagsCache[hash]; ok {
+		return flags, nil
+	}
+	usage, err := lister.GetNodeFlags(nodeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get node flags with binary %q: %w", nodeConfig.BinaryPath, err)
+	}
+	flags := &nodeFlags{
+		version: nodeSemVer,
+		types:   parseFlagUsage(usage),
+	}
+	if len(flags.types) == 0 {
+		ln.log.Warn("no flags listed by node binary, flags won't be validated", zap.String("binary-path", nodeConfig.BinaryPath))
+		flags = nil
+	}
+	nodeFlagsCache[hash] = flags
+	return flags, nil
+}
+
+// Returns the sha256 of the file at [binaryPath].
+func hashBinary(binaryPath string) (string, error) {
+	info, err := os.Stat(binaryPath)
+	if err != nil {
+		return "", err
+	}
+	fileInfo := binaryFileInfo{
+		path:    binaryPath,
+		size:    info.Size(),
+		modTime: info.ModTime(),
+	}
+	nodeFlagsCacheLock.Lock()
+	hash, ok := binaryHashCache[fileInfo]
+	nodeFlagsCacheLock.Unlock()
+	if ok {
+		return hash, nil
+	}
+
+	f, err := os.Open(binaryPath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	hash = hex.EncodeToString(h.Sum(nil))
+
+	nodeFlagsCacheLock.Lock()
+	binaryHashCache[fileInfo] = hash
+	nodeFlagsCacheLock.Unlock()
+	return hash, nil
+}
+
+// Returns the flags of a pflag or go flag usage listing, and their types.
+func parseFlagUsage(usage string) map[string]string {
+	types := map[string]string{}
+	scanner := bufio.NewScanner(strings.NewReader(usage))
+	for scanner.Scan() {
+		matches := flagUsageRe.FindStringSubmatch(scanner.Text())
+		if len(matches) != 3 {
+			continue
+		}
+		types[matches[1]] = matches[2]
+	}
+	return types
+}
+
+// Returns an error if a flag of [flags] isn't supported by the binary, or
+// its value isn't valid for its type.
+func (nf *nodeFlags) validate(flags map[string]string) error {
+	// sorted for a deterministic error
+	flagNames := maps.Keys(flags)
+	sort.Strings(flagNames)
+	for _, flagName := range flagNames {
+		flagType, ok := nf.types[flagName]
+		if !ok {
+			err := fmt.Errorf("%w %q for camino-node %s", ErrUnknownNodeFlag, flagName, nf.version)
+			if suggestions := nf.suggest(flagName); len(suggestions) > 0 {
+				for i := range suggestions {
+					suggestions[i] = strconv.Quote(suggestions[i])
+				}
+				err = fmt.Errorf("%w, did you mean %s?", err, strings.Join(suggestions, " or "))
+			}
+			return err
+		}
+		if err := validateFlagValue(flagType, flags[flagName]); err != nil {
+			return fmt.Errorf("%w %q for flag %q: %s", ErrInvalidNodeFlagValue, flags[flagName], flagName, err)
+		}
+	}
+	return nil
+}
+
+// Returns the supported flags closest to [flagName], if close enough.
+func (nf *nodeFlags) suggest(flagName string) []string {
+	bestDistance := maxFlagSuggestionDistance + 1
+	suggestions := []string{}
+	for name := range nf.types {
+		distance := editDistance(flagName, name)
+		switch {
+		case distance < bestDistance:
+			bestDistance = distance
+			suggestions = []string{name}
+		case distance == bestDistance:
+			suggestions = append(suggestions, name)
+		}
+	}
+	sort.Strings(suggestions)
+	return suggestions
+}
+
+// Returns an error if [value] can't be parsed as a flag of type [flagType].
+// Other types, such as strings and lists, accept any value.
+func validateFlagValue(flagType string, value string) error {
+	switch flagType {
+	case "", "bool":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errors.New("expected a bool")
+		}
+	case "int", "int8", "int16", "int32", "int64":
+		if _, err := strconv.ParseInt(value, 0, 64); err != nil {
+			return errors.New("expected an integer")
+		}
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		if _, err := strconv.ParseUint(value, 0, 64); err != nil {
+			return errors.New("expected a non negative integer")
+		}
+	case "float", "float32", "float64":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return errors.New("expected a number")
+		}
+	case "duration":
+		if _, err := time.ParseDuration(value); err != nil {
+			return errors.New("expected a duration, such as 1m30s")
+		}
+	}
+	return nil
+}
+
+// Levenshtein distance between [a] and [b].
+func editDistance(a string, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = math.Min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,235 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+var _ NodeFlagsLister = &localTestFlagsListerProcessCreator{}
+
+// Lists the flags in [usage], and counts the listings and started nodes.
+type localTestFlagsListerProcessCreator struct {
+	localTestSuccessfulNodeProcessCreator
+	usage    string
+	listings int
+	starts   int
+}
+
+func (lt *localTestFlagsListerProcessCreator) NewNodeProcess(config node.Config, flags ...string) (NodeProcess, error) {
+	lt.starts++
+	return newMockProcessSuccessful(config, flags...)
+}
+
+func (lt *localTestFlagsListerProcessCreator) GetNodeFlags(node.Config) (string, error) {
+	lt.listings++
+	return lt.usage, nil
+}
+
+const testGoFlagUsage = `Usage of camino-node:
+  -api-admin-enabled
+    	If true, this node exposes the Admin API
+  -benchlist-duration duration
+    	Max amount of time a peer is benchlisted (default 15m0s)
+  -http-port uint
+    	Port of the HTTP server (default 9650)
+  -log-level string
+    	The log level. Should be one of {verbo, debug, trace, info, warn, error, fatal, off} (default "info")
+  -uptime-requirement float
+    	Fraction of time a validator must be online to receive rewards (default 0.8)
+`
+
+const testPflagUsage = `Usage of camino-node:
+      --api-admin-enabled                 If true, this node exposes the Admin API
+      --benchlist-duration duration       Max amount of time a peer is benchlisted (default 15m0s)
+      --http-port uint                    Port of the HTTP server (default 9650)
+      --log-level string                  The log level (default "info")
+      --snow-sample-size int              Number of nodes to query for each network poll (default 20)
+  -h, --help                              help for camino-node
+pflag: help requested
+`
+
+func TestParseFlagUsage(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	require.Equal(map[string]string{
+		"api-admin-enabled":  "",
+		"benchlist-duration": "duration",
+		"http-port":          "uint",
+		"log-level":          "string",
+		"uptime-requirement": "float",
+	}, parseFlagUsage(testGoFlagUsage))
+	require.Equal(map[string]string{
+		"api-admin-enabled":  "",
+		"benchlist-duration": "duration",
+		"http-port":          "uint",
+		"log-level":          "string",
+		"snow-sample-size":   "int",
+		"help":               "",
+	}, parseFlagUsage(testPflagUsage))
+	require.Empty(parseFlagUsage("camino-node: v0.4.9\n"))
+}
+
+func TestNodeFlagsValidate(t *testing.T) {
+	t.Parallel()
+
+	nf := &nodeFlags{
+		version: "v0.4.9",
+		types:   parseFlagUsage(testGoFlagUsage),
+	}
+	tests := []struct {
+		name        string
+		flags       map[string]string
+		expectedErr error
+		errContains string
+	}{
+		{
+			name: "valid",
+			flags: map[string]string{
+				"api-admin-enabled":  "true",
+				"benchlist-duration": "1m30s",
+				"http-port":          "9650",
+				"log-level":          "debug",
+				"uptime-requirement": "0.5",
+			},
+		},
+		{
+			name:        "unknown flag with suggestion",
+			flags:       map[string]string{"htp-port": "9650"},
+			expectedErr: ErrUnknownNodeFlag,
+			errContains: `did you mean "http-port"?`,
+		},
+		{
+			name:        "unknown flag without suggestion",
+			flags:       map[string]string{"track-everything": "true"},
+			expectedErr: ErrUnknownNodeFlag,
+			errContains: `unknown node flag "track-everything" for camino-node v0.4.9`,
+		},
+		{
+			name:        "invalid bool",
+			flags:       map[string]string{"api-admin-enabled": "yes"},
+			expectedErr: ErrInvalidNodeFlagValue,
+		},
+		{
+			name:        "negative uint",
+			flags:       map[string]string{"http-port": "-1"},
+			expectedErr: ErrInvalidNodeFlagValue,
+		},
+		{
+			name:        "duration without unit",
+			flags:       map[string]string{"benchlist-duration": "60"},
+			expectedErr: ErrInvalidNodeFlagValue,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+			err := nf.validate(tt.flags)
+			if tt.expectedErr == nil {
+				require.NoError(err)
+				return
+			}
+			require.ErrorIs(err, tt.expectedErr)
+			require.ErrorContains(err, tt.errContains)
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	require.Equal(0, editDistance("http-port", "http-port"))
+	require.Equal(1, editDistance("htp-port", "http-port"))
+	require.Equal(2, editDistance("http-prot", "http-port"))
+	require.Equal(3, editDistance("", "abc"))
+}
+
+// Returns a fake binary, unique to the test, and a network config using it,
+// where all node and network flags are known to the binary.
+func testFlagsNetworkConfig(t *testing.T) (network.Config, string) {
+	require := require.New(t)
+	binaryPath := filepath.Join(t.TempDir(), "camino-node")
+	// the flags are cached per binary contents
+	require.NoError(os.WriteFile(binaryPath, []byte(t.Name()), 0o755))
+	networkConfig := testNetworkConfig(t)
+	networkConfig.BinaryPath = binaryPath
+	for i := range networkConfig.NodeConfigs {
+		networkConfig.NodeConfigs[i].BinaryPath = ""
+	}
+	usage := strings.Builder{}
+	addUsage := func(flags map[string]interface{}) {
+		for flagName := range flags {
+			fmt.Fprintf(&usage, "      --%s string   usage\n", flagName)
+		}
+	}
+	addUsage(networkConfig.Flags)
+	for _, nodeConfig := range networkConfig.NodeConfigs {
+		addUsage(nodeConfig.Flags)
+	}
+	fmt.Fprintf(&usage, "      --%s uint   usage\n", config.HTTPPortKey)
+	return networkConfig, usage.String()
+}
+
+func TestValidateFlagsBeforeStart(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	networkConfig, usage := testFlagsNetworkConfig(t)
+	// the last node is given an invalid flag
+	networkConfig.NodeConfigs[2].Flags["htp-port"] = 9650
+	creator := &localTestFlagsListerProcessCreator{usage: usage}
+	net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, creator, t.TempDir(), t.TempDir(), false)
+	require.NoError(err)
+	err = net.loadConfig(context.Background(), networkConfig)
+	require.ErrorIs(err, ErrUnknownNodeFlag)
+	require.ErrorContains(err, `did you mean "http-port"?`)
+	// no node was started
+	require.Zero(creator.starts)
+
+	networkConfig, usage = testFlagsNetworkConfig(t)
+	networkConfig.NodeConfigs[0].Flags[config.HTTPPortKey] = "abc"
+	creator = &localTestFlagsListerProcessCreator{usage: usage}
+	net, err = newNetwork(logging.NoLog{}, newMockAPISuccessful, creator, t.TempDir(), t.TempDir(), false)
+	require.NoError(err)
+	err = net.loadConfig(context.Background(), networkConfig)
+	require.ErrorIs(err, ErrInvalidNodeFlagValue)
+	require.Zero(creator.starts)
+}
+
+func TestValidateFlagsCached(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	networkConfig, usage := testFlagsNetworkConfig(t)
+	creator := &localTestFlagsListerProcessCreator{usage: usage}
+	net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, creator, t.TempDir(), t.TempDir(), false)
+	require.NoError(err)
+	require.NoError(net.loadConfig(context.Background(), networkConfig))
+	require.Equal(len(networkConfig.NodeConfigs), creator.starts)
+	// listed once for the same binary
+	require.Equal(1, creator.listings)
+
+	// nodes added later are validated too
+	_, err = net.AddNode(node.Config{
+		Name:  "node3",
+		Flags: map[string]interface{}{"unknown-flag": true},
+	})
+	require.ErrorIs(err, ErrUnknownNodeFlag)
+	require.Equal(len(networkConfig.NodeConfigs), creator.starts)
+	require.NoError(net.Stop(context.Background()))
+}
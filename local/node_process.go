@@ -16,7 +16,10 @@ import (
 	"go.uber.org/zap"
 )
 
-var _ NodeProcess = (*nodeProcess)(nil)
+var (
+	_ NodeProcess     = (*nodeProcess)(nil)
+	_ NodeFlagsLister = (*nodeProcessCreator)(nil)
+)
 
 // NodeProcess as an interface so we can mock running
 // CaminoGo binaries in tests
@@ -50,6 +53,17 @@ type nodeProcessCreator struct {
 	stderr io.Writer
 }
 
+// Returns a creator that executes camino-node binaries,
+// redirecting their output to os.Stdout and os.Stderr if so configured.
+func newDefaultNodeProcessCreator(log logging.Logger) NodeProcessCreator {
+	return &nodeProcessCreator{
+		colorPicker: utils.NewColorPicker(),
+		log:         log,
+		stdout:      os.Stdout,
+		stderr:      os.Stderr,
+	}
+}
+
 // NewNodeProcess creates a new process of the passed binary
 // If the config has redirection set to `true` for either StdErr or StdOut,
 // the output will be redirected and colored
@@ -216,3 +230,13 @@ func (*nodeProcessCreator) GetNodeVersion(config node.Config) (string, error) {
 	}
 	return string(out), nil
 }
+
+// GetNodeFlags gets the flag usage listing of the executable as per --help flag
+func (*nodeProcessCreator) GetNodeFlags(config node.Config) (string, error) {
+	// the usage may be printed to stderr, with a non zero exit code
+	out, err := exec.Command(config.BinaryPath, "--help").CombinedOutput() //nolint
+	if err != nil && len(out) == 0 {
+		return "", err
+	}
+	return string(out), nil
+}
@@ -0,0 +1,39 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanchego/config"
+)
+
+// C-chain config key of state pruning
+const pruningEnabledKey = "pruning-enabled"
+
+// Sets the flags and chain configs required by the role of [nodeConfig],
+// overriding the given ones. Archival nodes index the accepted containers
+// and keep the whole C-chain state.
+// Assumes the flags and chain config files of [nodeConfig] aren't nil.
+func applyNodeRole(nodeConfig *node.Config) error {
+	if nodeConfig.Role != node.ArchivalRole {
+		return nil
+	}
+	nodeConfig.Flags[config.IndexEnabledKey] = true
+	cChainConfig := map[string]json.RawMessage{}
+	if cChainConfigStr := nodeConfig.ChainConfigFiles["C"]; cChainConfigStr != "" {
+		if err := json.Unmarshal([]byte(cChainConfigStr), &cChainConfig); err != nil {
+			return fmt.Errorf("couldn't parse C-chain config of archival node %q: %w", nodeConfig.Name, err)
+		}
+	}
+	cChainConfig[pruningEnabledKey] = json.RawMessage("false")
+	cChainConfigBytes, err := json.Marshal(cChainConfig)
+	if err != nil {
+		return err
+	}
+	nodeConfig.ChainConfigFiles["C"] = string(cChainConfigBytes)
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyNodeRole(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	require.NoError(node.Role("").Validate())
+	require.NoError(node.APIRole.Validate())
+	require.Error(node.Role("beacon").Validate())
+	require.True(node.Role("").IsValidator())
+	require.False(node.ArchivalRole.IsValidator())
+
+	apiConfig := node.Config{
+		Role:             node.APIRole,
+		Flags:            map[string]interface{}{config.IndexEnabledKey: false},
+		ChainConfigFiles: map[string]string{"C": `{"pruning-enabled": true}`},
+	}
+	require.NoError(applyNodeRole(&apiConfig))
+	require.Equal(false, apiConfig.Flags[config.IndexEnabledKey])
+	require.Equal(`{"pruning-enabled": true}`, apiConfig.ChainConfigFiles["C"])
+
+	archivalConfig := node.Config{
+		Role:             node.ArchivalRole,
+		Flags:            map[string]interface{}{config.IndexEnabledKey: false},
+		ChainConfigFiles: map[string]string{"C": `{"pruning-enabled": true, "rpc-gas-cap": 50000000}`},
+	}
+	require.NoError(applyNodeRole(&archivalConfig))
+	require.Equal(true, archivalConfig.Flags[config.IndexEnabledKey])
+	cChainConfig := map[string]interface{}{}
+	require.NoError(json.Unmarshal([]byte(archivalConfig.ChainConfigFiles["C"]), &cChainConfig))
+	require.Equal(map[string]interface{}{"pruning-enabled": false, "rpc-gas-cap": float64(50000000)}, cChainConfig)
+
+	archivalConfig.ChainConfigFiles["C"] = "{"
+	require.ErrorContains(applyNodeRole(&archivalConfig), "couldn't parse C-chain config")
+}
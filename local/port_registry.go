@@ -0,0 +1,128 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+const portRegistryFileName = "ports.json"
+
+var (
+	ErrPortReserved = errors.New("port is reserved by another runner")
+	ErrPortNotFree  = errors.New("port is not free")
+	ErrNoFreePort   = errors.New("no free port in range")
+)
+
+// portRegistry hands out node ports to one network. Reservations are
+// recorded in a host registry file, so that concurrent networks never get
+// the same port.
+// Not safe for concurrent use; callers hold the network lock.
+type portRegistry struct {
+	// directory of the registry and lock files
+	dir string
+	// identifies this network in the registry
+	owner string
+	// range to pick free ports from
+	portRange network.PortRange
+	// ports reserved by this network
+	reserved set.Set[uint16]
+}
+
+func newPortRegistry(dir string, owner string) *portRegistry {
+	return &portRegistry{
+		dir:      dir,
+		owner:    owner,
+		reserved: set.Set[uint16]{},
+	}
+}
+
+// Returns the range to pick free ports from.
+func (r *portRegistry) getRange() network.PortRange {
+	if r.portRange.IsZero() {
+		return network.PortRange{Start: minPort, End: maxPort}
+	}
+	return r.portRange
+}
+
+// Runs [f] on the registry entries while holding the registry lock,
+// and saves them afterwards if [f] succeeds.
+func (r *portRegistry) update(f func(map[uint16]reservation) error) error {
+	return updateRegistry(r.dir, portRegistryFileName, f)
+}
+
+// Returns an error if [port] can't be reserved given the registry [entries].
+// A port reserved by another network is refused, even if that network runs
+// in this same process.
+func (r *portRegistry) checkPort(entries map[uint16]reservation, port uint16) error {
+	if entry, ok := entries[port]; ok && entry.Owner != r.owner {
+		return fmt.Errorf("%w: port %d, pid %d", ErrPortReserved, port, entry.PID)
+	}
+	if !r.reserved.Contains(port) && !waitFreePort(port) {
+		return fmt.Errorf("%w: port %d", ErrPortNotFree, port)
+	}
+	return nil
+}
+
+// reserve reserves the given [port] for this network.
+func (r *portRegistry) reserve(port uint16) error {
+	return r.update(func(entries map[uint16]reservation) error {
+		if err := r.checkPort(entries, port); err != nil {
+			return err
+		}
+		entries[port] = reservation{PID: os.Getpid(), Owner: r.owner}
+		r.reserved.Add(port)
+		return nil
+	})
+}
+
+// reserveFree reserves a free port of the range for this network.
+func (r *portRegistry) reserveFree() (uint16, error) {
+	portRange := r.getRange()
+	var port uint16
+	err := r.update(func(entries map[uint16]reservation) error {
+		size := int(portRange.End) - int(portRange.Start) + 1
+		// start at a random offset so that networks don't probe the same ports first
+		offset := rand.Intn(size) //nolint
+		for i := 0; i < size; i++ {
+			candidate := uint16(int(portRange.Start) + (offset+i)%size)
+			if _, ok := entries[candidate]; ok || r.reserved.Contains(candidate) {
+				continue
+			}
+			if !isFreePort(candidate) {
+				continue
+			}
+			entries[candidate] = reservation{PID: os.Getpid(), Owner: r.owner}
+			r.reserved.Add(candidate)
+			port = candidate
+			return nil
+		}
+		return fmt.Errorf("%w [%d, %d]", ErrNoFreePort, portRange.Start, portRange.End)
+	})
+	return port, err
+}
+
+// release releases the given [ports] of this network.
+func (r *portRegistry) release(ports ...uint16) error {
+	return r.update(func(entries map[uint16]reservation) error {
+		for _, port := range ports {
+			if entry, ok := entries[port]; ok && entry.Owner == r.owner {
+				delete(entries, port)
+			}
+			r.reserved.Remove(port)
+		}
+		return nil
+	})
+}
+
+// releaseAll releases all ports of this network.
+func (r *portRegistry) releaseAll() error {
+	return r.release(r.reserved.List()...)
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/stretchr/testify/require"
+)
+
+// A pid no process can have.
+const deadPID = 1 << 30
+
+func writeTestRegistry(t *testing.T, dir string, entries map[uint16]reservation) {
+	registryBytes, err := json.Marshal(entries)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, portRegistryFileName), registryBytes, registryFilePerms))
+}
+
+func TestPortRegistryRange(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	dir := t.TempDir()
+	portRange := network.PortRange{Start: 42100, End: 42103}
+	r1 := newPortRegistry(dir, "net1")
+	r1.portRange = portRange
+	r2 := newPortRegistry(dir, "net2")
+	r2.portRange = portRange
+
+	got := map[uint16]struct{}{}
+	for _, r := range []*portRegistry{r1, r2, r1, r2} {
+		port, err := r.reserveFree()
+		require.NoError(err)
+		require.GreaterOrEqual(port, portRange.Start)
+		require.LessOrEqual(port, portRange.End)
+		got[port] = struct{}{}
+	}
+	require.Len(got, 4)
+	_, err := r2.reserveFree()
+	require.ErrorIs(err, ErrNoFreePort)
+
+	require.NoError(r1.releaseAll())
+	for i := 0; i < 2; i++ {
+		_, err = r2.reserveFree()
+		require.NoError(err)
+	}
+}
+
+func TestPortRegistryOtherProcess(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	dir := t.TempDir()
+	writeTestRegistry(t, dir, map[uint16]reservation{
+		42110: {PID: os.Getppid(), Owner: "other"},
+		42111: {PID: deadPID, Owner: "gone"},
+	})
+	r := newPortRegistry(dir, "net")
+
+	err := r.reserve(42110)
+	require.ErrorIs(err, ErrPortReserved)
+	port, err := getPort(map[string]interface{}{"flag": 42110}, nil, "flag", true, r)
+	require.NoError(err)
+	require.NotEqual(uint16(42110), port)
+
+	// reservations of dead processes are reclaimed
+	require.NoError(r.reserve(42111))
+
+	// releasing doesn't touch reservations of others
+	require.NoError(r.releaseAll())
+	require.ErrorIs(r.reserve(42110), ErrPortReserved)
+}
+
+func TestPortRegistrySameProcess(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	dir := t.TempDir()
+	portRange := network.PortRange{Start: 42120, End: 42121}
+	r1 := newPortRegistry(dir, "net1")
+	r1.portRange = portRange
+	r2 := newPortRegistry(dir, "net2")
+	r2.portRange = portRange
+
+	port, err := r1.reserveFree()
+	require.NoError(err)
+	// a second network of this process can't take the port
+	require.ErrorIs(r2.reserve(port), ErrPortReserved)
+	otherPort, err := r2.reserveFree()
+	require.NoError(err)
+	require.NotEqual(port, otherPort)
+
+	// the owner can request its own port again
+	require.NoError(r1.reserve(port))
+
+	require.NoError(r1.releaseAll())
+	require.NoError(r2.reserve(port))
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+)
+
+const (
+	registryDirName   = "avalanche-network-runner-registry"
+	registryFilePerms = 0o644
+)
+
+// Shared by all runner processes on this host.
+var defaultRegistryDir = filepath.Join(os.TempDir(), registryDirName)
+
+// Entry of a host registry file.
+type reservation struct {
+	// Process of the runner that made the reservation
+	PID int `json:"pid"`
+	// Network that made the reservation
+	Owner string `json:"owner"`
+}
+
+// updateRegistry runs [f] on the entries of the host registry file [fileName]
+// in [dir] while holding an exclusive lock on it, and saves them afterwards if
+// [f] succeeds. Entries of processes that are gone are dropped before [f] runs.
+func updateRegistry[K comparable](dir string, fileName string, f func(map[K]reservation) error) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	registryPath := filepath.Join(dir, fileName)
+	lockFile, err := os.OpenFile(registryPath+".lock", os.O_CREATE|os.O_RDWR, registryFilePerms)
+	if err != nil {
+		return fmt.Errorf("couldn't open registry lock: %w", err)
+	}
+	defer lockFile.Close()
+	if err := syscall.Flock(int(lockFile.Fd()), syscall.LOCK_EX); err != nil {
+		return fmt.Errorf("couldn't lock registry: %w", err)
+	}
+	defer func() {
+		_ = syscall.Flock(int(lockFile.Fd()), syscall.LOCK_UN)
+	}()
+
+	entries := map[K]reservation{}
+	registryBytes, err := os.ReadFile(registryPath)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(registryBytes, &entries); err != nil {
+			// a corrupt registry only loses reservations, which are re-checked by the callers
+			entries = map[K]reservation{}
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return fmt.Errorf("couldn't read registry: %w", err)
+	}
+	for key, entry := range entries {
+		if !isProcessAlive(entry.PID) {
+			delete(entries, key)
+		}
+	}
+
+	if err := f(entries); err != nil {
+		return err
+	}
+
+	registryBytes, err = json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpPath := registryPath + ".tmp"
+	if err := os.WriteFile(tmpPath, registryBytes, registryFilePerms); err != nil {
+		return fmt.Errorf("couldn't write registry: %w", err)
+	}
+	return os.Rename(tmpPath, registryPath)
+}
+
+// Returns true if a process with [pid] exists.
+func isProcessAlive(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	err := syscall.Kill(pid, 0)
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
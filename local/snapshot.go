@@ -2,54 +2,33 @@ package local
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/ava-labs/avalanche-network-runner/api"
 	"github.com/ava-labs/avalanche-network-runner/network"
 	"github.com/ava-labs/avalanche-network-runner/network/node"
 	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanche-network-runner/utils/cas"
+	"github.com/ava-labs/avalanche-network-runner/utils/fastcopy"
 	"github.com/ava-labs/avalanchego/config"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/logging"
-	dircopy "github.com/otiai10/copy"
+	"go.uber.org/zap"
 	"golang.org/x/exp/maps"
 )
 
 const (
-	deprecatedBuildDirKey           = "build-dir"
-	deprecatedWhitelistedSubnetsKey = "whitelisted-subnets"
+	// snapshot store dir, in the snapshots dir
+	snapshotStoreDirName = ".store"
+	// manifest of the node dbs of a snapshot saved to the snapshot store
+	snapshotDBManifestFileName = "db-manifest.json"
 )
 
-// snapshots generated using older ANR versions may contain deprecated avago flags
-func fixDeprecatedAvagoFlags(flags map[string]interface{}) error {
-	if vIntf, ok := flags[deprecatedWhitelistedSubnetsKey]; ok {
-		v, ok := vIntf.(string)
-		if !ok {
-			return fmt.Errorf("expected %q to be of type string but got %T", deprecatedWhitelistedSubnetsKey, vIntf)
-		}
-		if v != "" {
-			flags[config.TrackSubnetsKey] = v
-		}
-		delete(flags, deprecatedWhitelistedSubnetsKey)
-	}
-	if vIntf, ok := flags[deprecatedBuildDirKey]; ok {
-		v, ok := vIntf.(string)
-		if !ok {
-			return fmt.Errorf("expected %q to be of type string but got %T", deprecatedBuildDirKey, vIntf)
-		}
-		if v != "" {
-			flags[config.PluginDirKey] = filepath.Join(v, "plugins")
-		}
-		delete(flags, deprecatedBuildDirKey)
-	}
-	return nil
-}
-
 // NewNetwork returns a new network from the given snapshot
 func NewNetworkFromSnapshot(
 	log logging.Logger,
@@ -64,15 +43,46 @@ func NewNetworkFromSnapshot(
 	flags map[string]interface{},
 	reassignPortsIfUsed bool,
 ) (network.Network, error) {
+	return NewNetworkFromSnapshotWithNodeProcessCreator(
+		log,
+		snapshotName,
+		rootDir,
+		snapshotsDir,
+		binaryPath,
+		pluginDir,
+		chainConfigs,
+		upgradeConfigs,
+		subnetConfigs,
+		flags,
+		reassignPortsIfUsed,
+		nil,
+	)
+}
+
+// NewNetworkFromSnapshotWithNodeProcessCreator is like NewNetworkFromSnapshot,
+// but node processes are launched by [nodeProcessCreator].
+// If [nodeProcessCreator] is nil, camino-node binaries are executed.
+func NewNetworkFromSnapshotWithNodeProcessCreator(
+	log logging.Logger,
+	snapshotName string,
+	rootDir string,
+	snapshotsDir string,
+	binaryPath string,
+	pluginDir string,
+	chainConfigs map[string]string,
+	upgradeConfigs map[string]string,
+	subnetConfigs map[string]string,
+	flags map[string]interface{},
+	reassignPortsIfUsed bool,
+	nodeProcessCreator NodeProcessCreator,
+) (network.Network, error) {
+	if nodeProcessCreator == nil {
+		nodeProcessCreator = newDefaultNodeProcessCreator(log)
+	}
 	net, err := newNetwork(
 		log,
 		api.NewAPIClient,
-		&nodeProcessCreator{
-			colorPicker: utils.NewColorPicker(),
-			log:         log,
-			stdout:      os.Stdout,
-			stderr:      os.Stderr,
-		},
+		nodeProcessCreator,
 		rootDir,
 		snapshotsDir,
 		reassignPortsIfUsed,
@@ -152,15 +162,25 @@ func (ln *localNetwork) SaveSnapshot(ctx context.Context, snapshotName string) (
 		return "", err
 	}
 	// save db
+	ln.dbCopies = map[string]fastcopy.Result{}
+	sourceDBDirs := map[string]string{}
 	for _, nodeConfig := range nodesConfig {
 		sourceDBDir, ok := nodesDBDir[nodeConfig.Name]
 		if !ok {
 			return "", fmt.Errorf("failure obtaining db path for node %q", nodeConfig.Name)
 		}
-		sourceDBDir = filepath.Join(sourceDBDir, constants.NetworkName(ln.networkID))
-		targetDBDir := filepath.Join(filepath.Join(snapshotDBDir, nodeConfig.Name), constants.NetworkName(ln.networkID))
-		if err := dircopy.Copy(sourceDBDir, targetDBDir); err != nil {
-			return "", fmt.Errorf("failure saving node %q db dir: %w", nodeConfig.Name, err)
+		sourceDBDirs[nodeConfig.Name] = filepath.Join(sourceDBDir, constants.NetworkName(ln.networkID))
+	}
+	if ln.dedupSnapshots {
+		if err := ln.saveDBDirsToStore(snapshotDir, sourceDBDirs); err != nil {
+			return "", err
+		}
+	} else {
+		for nodeName, sourceDBDir := range sourceDBDirs {
+			targetDBDir := filepath.Join(filepath.Join(snapshotDBDir, nodeName), constants.NetworkName(ln.networkID))
+			if err := ln.copyDBDir(nodeName, sourceDBDir, targetDBDir); err != nil {
+				return "", fmt.Errorf("failure saving node %q db dir: %w", nodeName, err)
+			}
 		}
 	}
 	// save network conf
@@ -172,20 +192,138 @@ func (ln *localNetwork) SaveSnapshot(ctx context.Context, snapshotName string) (
 		ChainConfigFiles:   ln.chainConfigFiles,
 		UpgradeConfigFiles: ln.upgradeConfigFiles,
 		SubnetConfigFiles:  ln.subnetConfigFiles,
+		PortRange:          ln.ports.portRange,
+		DedupSnapshots:     ln.dedupSnapshots,
+		SnapshotKeys:       ln.snapshotKeys,
+		ValidatorBond:      ln.validatorBond,
+		FundingKeyFile:     ln.fundingKeyFile,
+		ExternalPeers:      ln.externalPeers,
+	}
+	// private keys are only saved on request
+	if ln.snapshotKeys {
+		for _, key := range ln.subnetOwnerKeys {
+			networkConfig.SubnetOwnerKeys = append(networkConfig.SubnetOwnerKeys, key.String())
+		}
+		networkConfig.FundingKeys = ln.inlineFundingKeys
+		if ln.adminKey != nil {
+			networkConfig.AdminKey = ln.adminKey.String()
+		}
+	} else {
+		networkConfig.ValidatorBond.NodeOwnerKeys = nil
 	}
 
 	// no need to save this, will be generated automatically on snapshot load
 	networkConfig.NodeConfigs = append(networkConfig.NodeConfigs, maps.Values(nodesConfig)...)
-	networkConfigJSON, err := json.MarshalIndent(networkConfig, "", "    ")
-	if err != nil {
-		return "", err
+	snapshotConfig := snapshotNetworkConfig{
+		Config:       networkConfig,
+		BinaryHashes: ln.getBinaryHashes(networkConfig),
 	}
-	if err := createFileAndWrite(filepath.Join(snapshotDir, "network.json"), networkConfigJSON); err != nil {
+	if err := writeSnapshotNetworkConfig(snapshotDir, snapshotConfig); err != nil {
 		return "", err
 	}
 	return snapshotDir, nil
 }
 
+// Returns the hashes of the binaries of the nodes of [networkConfig], by
+// node name. Binaries that can't be read are skipped.
+func (ln *localNetwork) getBinaryHashes(networkConfig network.Config) map[string]string {
+	binaryHashes := map[string]string{}
+	for _, nodeConfig := range networkConfig.NodeConfigs {
+		binaryPath := nodeConfig.BinaryPath
+		if binaryPath == "" {
+			binaryPath = networkConfig.BinaryPath
+		}
+		hash, err := hashBinary(binaryPath)
+		if err != nil {
+			ln.log.Debug("couldn't hash node binary", zap.String("node-name", nodeConfig.Name), zap.Error(err))
+			continue
+		}
+		binaryHashes[nodeConfig.Name] = hash
+	}
+	return binaryHashes
+}
+
+// Copies the db dir of [nodeName] from [src] to [dst], sharing the contents
+// of the database files with [src] if the filesystem allows it.
+func (ln *localNetwork) copyDBDir(nodeName string, src string, dst string) error {
+	start := time.Now()
+	result, err := fastcopy.Dir(src, dst, fastcopy.IsImmutableDBFile)
+	if err != nil {
+		return err
+	}
+	ln.dbCopies[nodeName] = result
+	ln.log.Info("copied db dir",
+		zap.String("node-name", nodeName),
+		zap.String("strategy", string(result.Strategy())),
+		zap.Int("reflinked", result.Reflinked),
+		zap.Int("hardlinked", result.Hardlinked),
+		zap.Int("copied", result.Copied),
+		zap.Duration("elapsed", time.Since(start)),
+	)
+	return nil
+}
+
+// Saves the db dirs of the nodes, keyed by node name, to the snapshot store,
+// with a manifest of them in [snapshotDir].
+func (ln *localNetwork) saveDBDirsToStore(snapshotDir string, dbDirs map[string]string) error {
+	start := time.Now()
+	store, err := cas.Open(filepath.Join(ln.snapshotsDir, snapshotStoreDirName))
+	if err != nil {
+		return fmt.Errorf("failure opening snapshot store: %w", err)
+	}
+	defer store.Close()
+	stats, err := store.Save(filepath.Join(snapshotDir, snapshotDBManifestFileName), dbDirs)
+	if err != nil {
+		return fmt.Errorf("failure saving db dirs to snapshot store: %w", err)
+	}
+	ln.log.Info("saved db dirs to snapshot store",
+		zap.Int("chunks", stats.Chunks),
+		zap.Int64("bytes", stats.Bytes),
+		zap.Int("new-chunks", stats.NewChunks),
+		zap.Int64("new-stored-bytes", stats.NewStoredBytes),
+		zap.Duration("elapsed", time.Since(start)),
+	)
+	return nil
+}
+
+// Loads the db dirs of the nodes, keyed by node name, from the snapshot store.
+func (ln *localNetwork) loadDBDirsFromStore(snapshotDir string, dbDirs map[string]string) error {
+	start := time.Now()
+	store, err := cas.Open(filepath.Join(ln.snapshotsDir, snapshotStoreDirName))
+	if err != nil {
+		return fmt.Errorf("failure opening snapshot store: %w", err)
+	}
+	defer store.Close()
+	if err := store.Load(filepath.Join(snapshotDir, snapshotDBManifestFileName), dbDirs); err != nil {
+		return fmt.Errorf("failure loading db dirs from snapshot store: %w", err)
+	}
+	ln.log.Info("loaded db dirs from snapshot store", zap.Duration("elapsed", time.Since(start)))
+	return nil
+}
+
+// Removes the chunks of the snapshot store not used by any snapshot.
+func (ln *localNetwork) collectSnapshotStore() error {
+	storeDir := filepath.Join(ln.snapshotsDir, snapshotStoreDirName)
+	if _, err := os.Stat(storeDir); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	manifestPaths, err := filepath.Glob(filepath.Join(ln.snapshotsDir, snapshotPrefix+"*", snapshotDBManifestFileName))
+	if err != nil {
+		return err
+	}
+	store, err := cas.Open(storeDir)
+	if err != nil {
+		return fmt.Errorf("failure opening snapshot store: %w", err)
+	}
+	defer store.Close()
+	removed, err := store.GC(manifestPaths)
+	if err != nil {
+		return fmt.Errorf("failure collecting snapshot store: %w", err)
+	}
+	ln.log.Info("collected snapshot store", zap.Int("removed-chunks", removed))
+	return nil
+}
+
 // start network from snapshot
 func (ln *localNetwork) loadSnapshot(
 	ctx context.Context,
@@ -210,23 +348,18 @@ func (ln *localNetwork) loadSnapshot(
 			return fmt.Errorf("failure accessing snapshot %q: %w", snapshotName, err)
 		}
 	}
-	// load network config
-	networkConfigJSON, err := os.ReadFile(filepath.Join(snapshotDir, "network.json"))
+	// load network config, upgrading snapshots of older formats
+	snapshotConfig, migration, err := readSnapshotNetworkConfig(snapshotDir)
 	if err != nil {
-		return fmt.Errorf("failure reading network config file from snapshot: %w", err)
-	}
-	networkConfig := network.Config{}
-	if err := json.Unmarshal(networkConfigJSON, &networkConfig); err != nil {
-		return fmt.Errorf("failure unmarshaling network config from snapshot: %w", err)
-	}
-	// fix deprecated avago flags
-	if err := fixDeprecatedAvagoFlags(networkConfig.Flags); err != nil {
 		return err
 	}
-	for i := range networkConfig.NodeConfigs {
-		if err := fixDeprecatedAvagoFlags(networkConfig.NodeConfigs[i].Flags); err != nil {
-			return err
-		}
+	networkConfig := snapshotConfig.Config
+	if len(migration.Applied) > 0 {
+		ln.log.Info("migrated snapshot",
+			zap.String("snapshot-name", snapshotName),
+			zap.Uint32("from-version", migration.FromVersion),
+			zap.Uint32("to-version", migration.ToVersion),
+		)
 	}
 	// add flags
 	for i := range networkConfig.NodeConfigs {
@@ -235,13 +368,34 @@ func (ln *localNetwork) loadSnapshot(
 		}
 	}
 	// load db
+	ln.dbCopies = map[string]fastcopy.Result{}
+	_, err = os.Stat(filepath.Join(snapshotDir, snapshotDBManifestFileName))
+	inStore := err == nil
+	storeDBDirs := map[string]string{}
+	networkName := ""
+	if inStore {
+		networkID, err := utils.NetworkIDFromGenesis([]byte(networkConfig.Genesis))
+		if err != nil {
+			return err
+		}
+		networkName = constants.NetworkName(networkID)
+	}
 	for _, nodeConfig := range networkConfig.NodeConfigs {
-		sourceDBDir := filepath.Join(snapshotDBDir, nodeConfig.Name)
 		targetDBDir := filepath.Join(filepath.Join(ln.rootDir, nodeConfig.Name), defaultDBSubdir)
-		if err := dircopy.Copy(sourceDBDir, targetDBDir); err != nil {
+		nodeConfig.Flags[config.DBPathKey] = targetDBDir
+		if inStore {
+			storeDBDirs[nodeConfig.Name] = filepath.Join(targetDBDir, networkName)
+			continue
+		}
+		sourceDBDir := filepath.Join(snapshotDBDir, nodeConfig.Name)
+		if err := ln.copyDBDir(nodeConfig.Name, sourceDBDir, targetDBDir); err != nil {
 			return fmt.Errorf("failure loading node %q db dir: %w", nodeConfig.Name, err)
 		}
-		nodeConfig.Flags[config.DBPathKey] = targetDBDir
+	}
+	if inStore {
+		if err := ln.loadDBDirsFromStore(snapshotDir, storeDBDirs); err != nil {
+			return err
+		}
 	}
 	// replace binary path
 	if binaryPath != "" {
@@ -249,6 +403,16 @@ func (ln *localNetwork) loadSnapshot(
 			networkConfig.NodeConfigs[i].BinaryPath = binaryPath
 		}
 	}
+	// warn about nodes not running the binary they were saved with
+	for nodeName, hash := range ln.getBinaryHashes(networkConfig) {
+		if savedHash, ok := snapshotConfig.BinaryHashes[nodeName]; ok && hash != savedHash {
+			ln.log.Warn("node binary differs from the one the snapshot was saved with",
+				zap.String("node-name", nodeName),
+				zap.String("snapshot-binary-hash", savedHash),
+				zap.String("binary-hash", hash),
+			)
+		}
+	}
 	// replace plugin dir
 	if pluginDir != "" {
 		for i := range networkConfig.NodeConfigs {
@@ -293,7 +457,7 @@ func (ln *localNetwork) RemoveSnapshot(snapshotName string) error {
 	if err := os.RemoveAll(snapshotDir); err != nil {
 		return fmt.Errorf("failure removing snapshot path %q: %w", snapshotDir, err)
 	}
-	return nil
+	return ln.collectSnapshotStore()
 }
 
 // Get network snapshots
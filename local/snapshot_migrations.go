@@ -0,0 +1,323 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanche-network-runner/utils/constants"
+	"github.com/ava-labs/avalanchego/config"
+)
+
+const (
+	// Format version of the snapshots saved by this runner.
+	// Snapshots saved before versioning have version 0.
+	SnapshotFormatVersion = 3
+
+	snapshotNetworkConfigFileName = "network.json"
+
+	deprecatedBuildDirKey           = "build-dir"
+	deprecatedWhitelistedSubnetsKey = "whitelisted-subnets"
+	deprecatedImplSpecificConfigKey = "implSpecificConfig"
+)
+
+var ErrSnapshotFormatTooNew = errors.New("snapshot format is newer than supported by this runner")
+
+// Contents of network.json in a snapshot.
+type snapshotNetworkConfig struct {
+	network.Config
+	FormatVersion uint32 `json:"formatVersion"`
+	// Version of the runner that last wrote the snapshot
+	RunnerVersion string `json:"runnerVersion"`
+	// Node name --> sha256 of the binary the node ran when saved
+	BinaryHashes map[string]string `json:"binaryHashes,omitempty"`
+}
+
+// snapshotMigration upgrades a snapshot to the next format version.
+// Migrations update the decoded network.json of the snapshot, and must not
+// modify the snapshot dir, as snapshots are migrated in memory on load.
+type snapshotMigration struct {
+	description string
+	migrate     func(networkConfig map[string]interface{}) error
+}
+
+// snapshotMigrations[i] upgrades a snapshot from format version i to i+1.
+// New migrations are appended, bumping SnapshotFormatVersion.
+var snapshotMigrations = []snapshotMigration{
+	{
+		description: "move implSpecificConfig of node configs to node config fields",
+		migrate:     migrateImplSpecificConfig,
+	},
+	{
+		description: "rename deprecated node flags",
+		migrate:     migrateDeprecatedFlags,
+	},
+	{
+		description: "remove log dir references",
+		migrate:     migrateLogsDir,
+	},
+}
+
+// SnapshotMigrationResult describes a snapshot migration.
+type SnapshotMigrationResult struct {
+	FromVersion uint32
+	ToVersion   uint32
+	// Descriptions of the applied migrations
+	Applied []string
+}
+
+// MigrateSnapshot upgrades the snapshot [snapshotName] in [snapshotsDir] to
+// SnapshotFormatVersion, rewriting it in place. If [snapshotsDir] is empty,
+// the default snapshots dir is used.
+func MigrateSnapshot(snapshotsDir string, snapshotName string) (SnapshotMigrationResult, error) {
+	if snapshotsDir == "" {
+		snapshotsDir = defaultSnapshotsDir
+	}
+	snapshotDir := filepath.Join(snapshotsDir, snapshotPrefix+snapshotName)
+	if _, err := os.Stat(snapshotDir); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return SnapshotMigrationResult{}, ErrSnapshotNotFound
+		}
+		return SnapshotMigrationResult{}, fmt.Errorf("failure accessing snapshot %q: %w", snapshotName, err)
+	}
+	snapshotConfig, result, err := readSnapshotNetworkConfig(snapshotDir)
+	if err != nil {
+		return result, err
+	}
+	if len(result.Applied) == 0 {
+		return result, nil
+	}
+	return result, writeSnapshotNetworkConfig(snapshotDir, snapshotConfig)
+}
+
+// Reads the network config of the snapshot at [snapshotDir], migrated to
+// SnapshotFormatVersion.
+func readSnapshotNetworkConfig(snapshotDir string) (snapshotNetworkConfig, SnapshotMigrationResult, error) {
+	result := SnapshotMigrationResult{}
+	networkConfigJSON, err := os.ReadFile(filepath.Join(snapshotDir, snapshotNetworkConfigFileName))
+	if err != nil {
+		return snapshotNetworkConfig{}, result, fmt.Errorf("failure reading network config file from snapshot: %w", err)
+	}
+	// numbers are kept as is, to not change flag values on migration
+	decoder := json.NewDecoder(bytes.NewReader(networkConfigJSON))
+	decoder.UseNumber()
+	rawNetworkConfig := map[string]interface{}{}
+	if err := decoder.Decode(&rawNetworkConfig); err != nil {
+		return snapshotNetworkConfig{}, result, fmt.Errorf("failure unmarshaling network config from snapshot: %w", err)
+	}
+	result, err = migrateSnapshotNetworkConfig(rawNetworkConfig)
+	if err != nil {
+		return snapshotNetworkConfig{}, result, err
+	}
+	networkConfigJSON, err = json.Marshal(rawNetworkConfig)
+	if err != nil {
+		return snapshotNetworkConfig{}, result, err
+	}
+	snapshotConfig := snapshotNetworkConfig{}
+	if err := json.Unmarshal(networkConfigJSON, &snapshotConfig); err != nil {
+		return snapshotNetworkConfig{}, result, fmt.Errorf("failure unmarshaling network config from snapshot: %w", err)
+	}
+	return snapshotConfig, result, nil
+}
+
+// Writes the network config of the snapshot at [snapshotDir], with the
+// current format and runner versions.
+func writeSnapshotNetworkConfig(snapshotDir string, snapshotConfig snapshotNetworkConfig) error {
+	snapshotConfig.FormatVersion = SnapshotFormatVersion
+	snapshotConfig.RunnerVersion = constants.Version
+	networkConfigJSON, err := json.MarshalIndent(snapshotConfig, "", "    ")
+	if err != nil {
+		return err
+	}
+	networkConfigPath := filepath.Join(snapshotDir, snapshotNetworkConfigFileName)
+	tmpPath := networkConfigPath + ".tmp"
+	if err := os.MkdirAll(snapshotDir, 0o750); err != nil {
+		return err
+	}
+	// only readable by the owner, as it may hold private keys
+	if err := os.WriteFile(tmpPath, networkConfigJSON, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, networkConfigPath)
+}
+
+// Applies the migrations needed by the decoded network.json [networkConfig].
+func migrateSnapshotNetworkConfig(networkConfig map[string]interface{}) (SnapshotMigrationResult, error) {
+	result := SnapshotMigrationResult{}
+	if v, ok := networkConfig["formatVersion"]; ok {
+		number, ok := v.(json.Number)
+		if !ok {
+			return result, fmt.Errorf("expected snapshot format version to be a number but got %T", v)
+		}
+		version, err := number.Int64()
+		if err != nil || version < 0 {
+			return result, fmt.Errorf("invalid snapshot format version %q", number)
+		}
+		result.FromVersion = uint32(version)
+	}
+	if result.FromVersion > SnapshotFormatVersion {
+		return result, fmt.Errorf("%w: version %d, supported %d", ErrSnapshotFormatTooNew, result.FromVersion, SnapshotFormatVersion)
+	}
+	for version := result.FromVersion; version < SnapshotFormatVersion; version++ {
+		migration := snapshotMigrations[version]
+		if err := migration.migrate(networkConfig); err != nil {
+			return result, fmt.Errorf("failure migrating snapshot from format version %d (%s): %w", version, migration.description, err)
+		}
+		result.Applied = append(result.Applied, migration.description)
+	}
+	result.ToVersion = SnapshotFormatVersion
+	networkConfig["formatVersion"] = json.Number(fmt.Sprint(SnapshotFormatVersion))
+	return result, nil
+}
+
+// Returns the node configs of a decoded network.json.
+func getRawNodeConfigs(networkConfig map[string]interface{}) ([]map[string]interface{}, error) {
+	v, ok := networkConfig["nodeConfigs"]
+	if !ok || v == nil {
+		return nil, nil
+	}
+	nodeConfigsIntf, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected nodeConfigs to be a list but got %T", v)
+	}
+	nodeConfigs := make([]map[string]interface{}, len(nodeConfigsIntf))
+	for i, nodeConfigIntf := range nodeConfigsIntf {
+		nodeConfig, ok := nodeConfigIntf.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected node config to be an object but got %T", nodeConfigIntf)
+		}
+		nodeConfigs[i] = nodeConfig
+	}
+	return nodeConfigs, nil
+}
+
+// Returns the flags of a decoded network or node config, nil if not set.
+func getRawFlags(config map[string]interface{}) (map[string]interface{}, error) {
+	v, ok := config["flags"]
+	if !ok || v == nil {
+		return nil, nil
+	}
+	flags, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected flags to be an object but got %T", v)
+	}
+	return flags, nil
+}
+
+// Runs [f] on the flags of the network config and of all node configs.
+func forEachRawFlags(networkConfig map[string]interface{}, f func(map[string]interface{}) error) error {
+	configs, err := getRawNodeConfigs(networkConfig)
+	if err != nil {
+		return err
+	}
+	configs = append(configs, networkConfig)
+	for _, config := range configs {
+		flags, err := getRawFlags(config)
+		if err != nil {
+			return err
+		}
+		if flags == nil {
+			continue
+		}
+		if err := f(flags); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Early snapshots kept the binary path and output redirection of nodes in an
+// implementation specific object.
+func migrateImplSpecificConfig(networkConfig map[string]interface{}) error {
+	nodeConfigs, err := getRawNodeConfigs(networkConfig)
+	if err != nil {
+		return err
+	}
+	for _, nodeConfig := range nodeConfigs {
+		v, ok := nodeConfig[deprecatedImplSpecificConfigKey]
+		if !ok {
+			continue
+		}
+		delete(nodeConfig, deprecatedImplSpecificConfigKey)
+		if v == nil {
+			continue
+		}
+		implSpecificConfig, ok := v.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("expected %q to be an object but got %T", deprecatedImplSpecificConfigKey, v)
+		}
+		for _, key := range []string{"binaryPath", "redirectStdout", "redirectStderr"} {
+			if _, ok := nodeConfig[key]; ok {
+				continue
+			}
+			if v, ok := implSpecificConfig[key]; ok {
+				nodeConfig[key] = v
+			}
+		}
+	}
+	return nil
+}
+
+func migrateDeprecatedFlags(networkConfig map[string]interface{}) error {
+	return forEachRawFlags(networkConfig, fixDeprecatedAvagoFlags)
+}
+
+// snapshots generated using older ANR versions may contain deprecated avago flags
+func fixDeprecatedAvagoFlags(flags map[string]interface{}) error {
+	if vIntf, ok := flags[deprecatedWhitelistedSubnetsKey]; ok {
+		v, ok := vIntf.(string)
+		if !ok {
+			return fmt.Errorf("expected %q to be of type string but got %T", deprecatedWhitelistedSubnetsKey, vIntf)
+		}
+		if v != "" {
+			flags[config.TrackSubnetsKey] = v
+		}
+		delete(flags, deprecatedWhitelistedSubnetsKey)
+	}
+	if vIntf, ok := flags[deprecatedBuildDirKey]; ok {
+		v, ok := vIntf.(string)
+		if !ok {
+			return fmt.Errorf("expected %q to be of type string but got %T", deprecatedBuildDirKey, vIntf)
+		}
+		if v != "" {
+			flags[config.PluginDirKey] = filepath.Join(v, "plugins")
+		}
+		delete(flags, deprecatedBuildDirKey)
+	}
+	return nil
+}
+
+// Log dirs of the saving network were kept by older snapshots, making the
+// loaded nodes log to them.
+func migrateLogsDir(networkConfig map[string]interface{}) error {
+	if err := forEachRawFlags(networkConfig, func(flags map[string]interface{}) error {
+		delete(flags, config.LogsDirKey)
+		return nil
+	}); err != nil {
+		return err
+	}
+	nodeConfigs, err := getRawNodeConfigs(networkConfig)
+	if err != nil {
+		return err
+	}
+	for _, nodeConfig := range nodeConfigs {
+		configFile, ok := nodeConfig["configFile"].(string)
+		if !ok || configFile == "" {
+			continue
+		}
+		configFile, err := utils.SetJSONKey(configFile, config.LogsDirKey, "")
+		if err != nil {
+			return fmt.Errorf("failure updating node config file: %w", err)
+		}
+		nodeConfig["configFile"] = configFile
+	}
+	return nil
+}
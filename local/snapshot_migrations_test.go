@@ -0,0 +1,165 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+// network.json of a snapshot saved before versioning
+const legacySnapshotNetworkConfig = `{
+    "genesis": "{}",
+    "flags": {
+        "log-level": "INFO",
+        "whitelisted-subnets": "p433wpuXyJiDhyazPYyZMJeaoPSW76CBZ2x7wrVPLgvokotXz",
+        "log-dir": "/tmp/old/logs"
+    },
+    "nodeConfigs": [
+        {
+            "name": "node1",
+            "isBeacon": true,
+            "configFile": "{\"network-id\":1337,\"log-dir\":\"/tmp/old/node1/logs\"}",
+            "flags": {
+                "http-port": 9650,
+                "build-dir": "/tmp/build",
+                "log-dir": "/tmp/old/node1/logs"
+            },
+            "implSpecificConfig": {
+                "binaryPath": "/tmp/build/camino-node",
+                "redirectStdout": true
+            }
+        }
+    ]
+}`
+
+func writeTestSnapshot(t *testing.T, snapshotsDir string, snapshotName string, networkConfig string) string {
+	snapshotDir := filepath.Join(snapshotsDir, snapshotPrefix+snapshotName)
+	require.NoError(t, os.MkdirAll(snapshotDir, os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(snapshotDir, snapshotNetworkConfigFileName), []byte(networkConfig), 0o644))
+	return snapshotDir
+}
+
+func TestSnapshotMigrationsCount(t *testing.T) {
+	require.Len(t, snapshotMigrations, SnapshotFormatVersion)
+}
+
+func TestMigrateSnapshot(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	snapshotsDir := t.TempDir()
+	snapshotDir := writeTestSnapshot(t, snapshotsDir, "legacy", legacySnapshotNetworkConfig)
+
+	result, err := MigrateSnapshot(snapshotsDir, "legacy")
+	require.NoError(err)
+	require.Equal(uint32(0), result.FromVersion)
+	require.Equal(uint32(SnapshotFormatVersion), result.ToVersion)
+	require.Len(result.Applied, SnapshotFormatVersion)
+
+	networkConfigJSON, err := os.ReadFile(filepath.Join(snapshotDir, snapshotNetworkConfigFileName))
+	require.NoError(err)
+	snapshotConfig := snapshotNetworkConfig{}
+	require.NoError(json.Unmarshal(networkConfigJSON, &snapshotConfig))
+	require.Equal(uint32(SnapshotFormatVersion), snapshotConfig.FormatVersion)
+
+	networkConfig := snapshotConfig.Config
+	require.Equal(map[string]interface{}{
+		"log-level":            "INFO",
+		config.TrackSubnetsKey: "p433wpuXyJiDhyazPYyZMJeaoPSW76CBZ2x7wrVPLgvokotXz",
+	}, networkConfig.Flags)
+	require.Len(networkConfig.NodeConfigs, 1)
+	nodeConfig := networkConfig.NodeConfigs[0]
+	require.Equal("node1", nodeConfig.Name)
+	require.True(nodeConfig.IsBeacon)
+	require.Equal("/tmp/build/camino-node", nodeConfig.BinaryPath)
+	require.True(nodeConfig.RedirectStdout)
+	require.False(nodeConfig.RedirectStderr)
+	require.Equal(map[string]interface{}{
+		config.HTTPPortKey:  float64(9650),
+		config.PluginDirKey: filepath.Join("/tmp/build", "plugins"),
+	}, nodeConfig.Flags)
+	require.JSONEq(`{"network-id":1337}`, nodeConfig.ConfigFile)
+	// numbers are written back as is
+	require.Contains(string(networkConfigJSON), `"http-port": 9650`)
+
+	// migrating again is a no-op
+	result, err = MigrateSnapshot(snapshotsDir, "legacy")
+	require.NoError(err)
+	require.Equal(uint32(SnapshotFormatVersion), result.FromVersion)
+	require.Equal(uint32(SnapshotFormatVersion), result.ToVersion)
+	require.Empty(result.Applied)
+	migratedJSON, err := os.ReadFile(filepath.Join(snapshotDir, snapshotNetworkConfigFileName))
+	require.NoError(err)
+	require.Equal(networkConfigJSON, migratedJSON)
+}
+
+func TestReadSnapshotNetworkConfig(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	snapshotsDir := t.TempDir()
+	snapshotDir := writeTestSnapshot(t, snapshotsDir, "legacy", legacySnapshotNetworkConfig)
+
+	// loading migrates in memory only
+	networkConfig, result, err := readSnapshotNetworkConfig(snapshotDir)
+	require.NoError(err)
+	require.Len(result.Applied, SnapshotFormatVersion)
+	require.Equal("/tmp/build/camino-node", networkConfig.NodeConfigs[0].BinaryPath)
+	networkConfigJSON, err := os.ReadFile(filepath.Join(snapshotDir, snapshotNetworkConfigFileName))
+	require.NoError(err)
+	require.Equal(legacySnapshotNetworkConfig, string(networkConfigJSON))
+}
+
+func TestMigrateSnapshotErrors(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	snapshotsDir := t.TempDir()
+	_, err := MigrateSnapshot(snapshotsDir, "missing")
+	require.ErrorIs(err, ErrSnapshotNotFound)
+
+	writeTestSnapshot(t, snapshotsDir, "future", `{"formatVersion": 1000, "genesis": "{}"}`)
+	_, err = MigrateSnapshot(snapshotsDir, "future")
+	require.ErrorIs(err, ErrSnapshotFormatTooNew)
+
+	writeTestSnapshot(t, snapshotsDir, "invalid", `{"nodeConfigs": [{"implSpecificConfig": "binary"}]}`)
+	_, err = MigrateSnapshot(snapshotsDir, "invalid")
+	require.ErrorContains(err, "implSpecificConfig")
+}
+
+func TestSnapshotBinaryHashes(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	binaryPath := filepath.Join(t.TempDir(), "camino-node")
+	require.NoError(os.WriteFile(binaryPath, []byte(t.Name()), 0o755))
+	networkConfig := testNetworkConfig(t)
+	networkConfig.BinaryPath = binaryPath
+	networkConfig.NodeConfigs[0].BinaryPath = ""
+	networkConfig.NodeConfigs[1].BinaryPath = binaryPath
+	// unreadable binaries are skipped
+	networkConfig.NodeConfigs[2].BinaryPath = filepath.Join(t.TempDir(), "missing")
+	ln := &localNetwork{log: logging.NoLog{}}
+	binaryHashes := ln.getBinaryHashes(networkConfig)
+	require.Len(binaryHashes, 2)
+	hash, err := hashBinary(binaryPath)
+	require.NoError(err)
+	require.Equal(hash, binaryHashes[networkConfig.NodeConfigs[0].Name])
+
+	snapshotDir := writeTestSnapshot(t, t.TempDir(), "hashes", "{}")
+	require.NoError(writeSnapshotNetworkConfig(snapshotDir, snapshotNetworkConfig{
+		Config:       networkConfig,
+		BinaryHashes: binaryHashes,
+	}))
+	snapshotConfig, _, err := readSnapshotNetworkConfig(snapshotDir)
+	require.NoError(err)
+	require.Equal(binaryHashes, snapshotConfig.BinaryHashes)
+}
@@ -0,0 +1,222 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/utils/fastcopy"
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	// check period while waiting for restarted nodes to bootstrap the chains of a replaced VM
+	vmChainsBootstrapPullFrequency = time.Second
+	// dir under the network root dir with the plugin dirs of the nodes whose VM plugins were replaced
+	vmPluginsDirName = "plugins"
+)
+
+var (
+	ErrVMNotInUse    = errors.New("no blockchain runs the vm")
+	errNoRunningNode = errors.New("no running node")
+)
+
+// ReplaceVMPlugin copies the plugin binary at [pluginPath] as the plugin of
+// VM [vmID] for every node tracking a subnet with a blockchain of the VM.
+// The plugin dirs of the nodes are left untouched, as they may be shared with
+// other networks: each node gets a copy of its plugin dir under the root dir
+// of the network, with the new plugin in it. The nodes are then restarted
+// with that plugin dir, keeping their databases, and the call waits for them
+// to bootstrap the blockchains of the VM again. Paused nodes use the new
+// plugin dir once resumed. Returns the names of the restarted nodes.
+func (ln *localNetwork) ReplaceVMPlugin(ctx context.Context, vmID ids.ID, pluginPath string) ([]string, error) {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if ln.stopCalled() {
+		return nil, network.ErrStopped
+	}
+	someNode := ln.getSomeNode()
+	if someNode == nil {
+		return nil, errNoRunningNode
+	}
+	cctx, cancel := createDefaultCtx(ctx)
+	blockchains, err := someNode.GetAPIClient().PChainAPI().GetBlockchains(cctx)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+	chainIDs := []ids.ID{}
+	subnetIDs := set.Set[ids.ID]{}
+	for _, blockchain := range blockchains {
+		if blockchain.VMID == vmID {
+			chainIDs = append(chainIDs, blockchain.ID)
+			subnetIDs.Add(blockchain.SubnetID)
+		}
+	}
+	if len(chainIDs) == 0 {
+		return nil, fmt.Errorf("%w %s", ErrVMNotInUse, vmID)
+	}
+
+	pluginDirs, err := ln.getTrackingPluginDirs(subnetIDs)
+	if err != nil {
+		return nil, err
+	}
+	nodeNames := maps.Keys(pluginDirs)
+	sort.Strings(nodeNames)
+	restarted := []string{}
+	for _, nodeName := range nodeNames {
+		networkPluginDir := filepath.Join(ln.rootDir, vmPluginsDirName, nodeName)
+		if err := copyPluginDir(pluginDirs[nodeName], networkPluginDir); err != nil {
+			return nil, fmt.Errorf("failure copying plugin dir of node %q: %w", nodeName, err)
+		}
+		pluginExecPath := filepath.Join(networkPluginDir, vmID.String())
+		ln.log.Info("replacing vm plugin", zap.String("node-name", nodeName), zap.String("path", pluginExecPath))
+		if err := replacePluginFile(pluginPath, pluginExecPath); err != nil {
+			return nil, fmt.Errorf("failure replacing vm plugin %q: %w", pluginExecPath, err)
+		}
+		node := ln.nodes[nodeName]
+		if node.paused {
+			if node.config.Flags == nil {
+				node.config.Flags = map[string]interface{}{}
+			}
+			node.config.Flags[config.PluginDirKey] = networkPluginDir
+			node.pluginDir = networkPluginDir
+			continue
+		}
+		ln.log.Info(logging.Green.Wrap(fmt.Sprintf("restarting node %s to load the new plugin of vm %s", nodeName, vmID)))
+		if err := ln.restartNode(ctx, nodeName, "", networkPluginDir, "", nil, nil, nil); err != nil {
+			return nil, err
+		}
+		restarted = append(restarted, nodeName)
+	}
+	if err := ln.healthy(ctx); err != nil {
+		return nil, err
+	}
+	if err := ln.waitChainsBootstrapped(ctx, restarted, chainIDs); err != nil {
+		return nil, err
+	}
+	return restarted, nil
+}
+
+// Returns the plugin dirs of the nodes tracking any of [subnetIDs], by node name.
+func (ln *localNetwork) getTrackingPluginDirs(subnetIDs set.Set[ids.ID]) (map[string]string, error) {
+	pluginDirs := map[string]string{}
+	for nodeName, node := range ln.nodes {
+		trackSubnets, err := node.GetFlag(config.TrackSubnetsKey)
+		if err != nil {
+			return nil, err
+		}
+		if !tracksAnySubnet(trackSubnets, subnetIDs) {
+			continue
+		}
+		pluginDir := node.GetPluginDir()
+		if pluginDir == "" {
+			// default plugin dir of camino-node
+			pluginDir = filepath.Join(filepath.Dir(node.GetBinaryPath()), "plugins")
+		}
+		ln.log.Debug("node tracks the subnets of the vm", zap.String("node-name", nodeName), zap.String("plugin-dir", pluginDir))
+		pluginDirs[nodeName] = pluginDir
+	}
+	return pluginDirs, nil
+}
+
+// Copies the plugins of [src] to [dst], replacing any previous contents of
+// [dst]. Nothing is copied if [src] is [dst], as for a node whose plugin was
+// already replaced, or if [src] doesn't exist.
+func copyPluginDir(src string, dst string) error {
+	if filepath.Clean(src) == filepath.Clean(dst) {
+		return nil
+	}
+	if err := os.RemoveAll(dst); err != nil {
+		return err
+	}
+	if _, err := os.Stat(src); errors.Is(err, os.ErrNotExist) {
+		return os.MkdirAll(dst, 0o755)
+	}
+	_, err := fastcopy.Dir(src, dst, nil)
+	return err
+}
+
+// Returns true if the comma separated subnet IDs [trackSubnets] contain
+// any of [subnetIDs].
+func tracksAnySubnet(trackSubnets string, subnetIDs set.Set[ids.ID]) bool {
+	for _, s := range strings.Split(trackSubnets, ",") {
+		subnetID, err := ids.FromString(strings.TrimSpace(s))
+		if err == nil && subnetIDs.Contains(subnetID) {
+			return true
+		}
+	}
+	return false
+}
+
+// Replaces the plugin at [dst] by a copy of [src].
+// The copy is renamed over [dst], as a running plugin can't be written to.
+func replacePluginFile(src string, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	tmpPath := dst + ".tmp"
+	out, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o755)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	if err := out.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	return os.Rename(tmpPath, dst)
+}
+
+// Waits for [nodeNames] to report [chainIDs] as bootstrapped.
+func (ln *localNetwork) waitChainsBootstrapped(ctx context.Context, nodeNames []string, chainIDs []ids.ID) error {
+	ln.log.Info(logging.Blue.Wrap(logging.Bold.Wrap("waiting for the restarted nodes to bootstrap the vm chains...")))
+	for _, nodeName := range nodeNames {
+		infoClient := ln.nodes[nodeName].GetAPIClient().InfoAPI()
+		for _, chainID := range chainIDs {
+			for {
+				cctx, cancel := createDefaultCtx(ctx)
+				bootstrapped, err := infoClient.IsBootstrapped(cctx, chainID.String())
+				cancel()
+				if err == nil && bootstrapped {
+					break
+				}
+				ln.log.Debug("chain not bootstrapped yet",
+					zap.String("node-name", nodeName),
+					zap.Stringer("blockchain-ID", chainID),
+					zap.Error(err),
+				)
+				select {
+				case <-ln.onStopCh:
+					return errAborted
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(vmChainsBootstrapPullFrequency):
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracksAnySubnet(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	subnetID1, subnetID2 := ids.GenerateTestID(), ids.GenerateTestID()
+	subnetIDs := set.Set[ids.ID]{subnetID1: struct{}{}}
+	require.True(tracksAnySubnet(subnetID1.String(), subnetIDs))
+	require.True(tracksAnySubnet(subnetID2.String()+", "+subnetID1.String(), subnetIDs))
+	require.False(tracksAnySubnet(subnetID2.String(), subnetIDs))
+	require.False(tracksAnySubnet("", subnetIDs))
+}
+
+func TestGetTrackingPluginDirs(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	subnetID1, subnetID2 := ids.GenerateTestID(), ids.GenerateTestID()
+	ln := &localNetwork{
+		log: logging.NoLog{},
+		nodes: map[string]*localNode{
+			"node1": {
+				config:    node.Config{Flags: map[string]interface{}{config.TrackSubnetsKey: subnetID1.String()}},
+				pluginDir: "/plugins1",
+			},
+			"node2": {
+				config:    node.Config{Flags: map[string]interface{}{config.TrackSubnetsKey: subnetID1.String()}},
+				pluginDir: "/plugins1",
+			},
+			"node3": {
+				config: node.Config{
+					BinaryPath: "/build/camino-node",
+					ConfigFile: `{"` + config.TrackSubnetsKey + `":"` + subnetID1.String() + `"}`,
+				},
+			},
+			"node4": {
+				config:    node.Config{Flags: map[string]interface{}{config.TrackSubnetsKey: subnetID2.String()}},
+				pluginDir: "/plugins4",
+			},
+			"node5": {
+				pluginDir: "/plugins5",
+			},
+		},
+	}
+	pluginDirs, err := ln.getTrackingPluginDirs(set.Set[ids.ID]{subnetID1: struct{}{}})
+	require.NoError(err)
+	require.Equal(map[string]string{
+		"node1": "/plugins1",
+		"node2": "/plugins1",
+		"node3": "/build/plugins",
+	}, pluginDirs)
+}
+
+func TestCopyPluginDir(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src")
+	dst := filepath.Join(dir, "dst")
+	require.NoError(os.MkdirAll(src, 0o755))
+	require.NoError(os.WriteFile(filepath.Join(src, "vm"), []byte("vm"), 0o755))
+	require.NoError(os.MkdirAll(dst, 0o755))
+	require.NoError(os.WriteFile(filepath.Join(dst, "stale"), []byte("stale"), 0o755))
+
+	require.NoError(copyPluginDir(src, dst))
+	entries, err := os.ReadDir(dst)
+	require.NoError(err)
+	require.Len(entries, 1)
+	contents, err := os.ReadFile(filepath.Join(dst, "vm"))
+	require.NoError(err)
+	require.Equal([]byte("vm"), contents)
+
+	// replacing a plugin of the copy leaves the source untouched
+	require.NoError(os.WriteFile(filepath.Join(dir, "new-vm"), []byte("new"), 0o644))
+	require.NoError(replacePluginFile(filepath.Join(dir, "new-vm"), filepath.Join(dst, "vm")))
+	contents, err = os.ReadFile(filepath.Join(src, "vm"))
+	require.NoError(err)
+	require.Equal([]byte("vm"), contents)
+
+	// copying a dir onto itself keeps it
+	require.NoError(copyPluginDir(dst, dst))
+	contents, err = os.ReadFile(filepath.Join(dst, "vm"))
+	require.NoError(err)
+	require.Equal([]byte("new"), contents)
+
+	// a missing source gives an empty dir
+	require.NoError(copyPluginDir(filepath.Join(dir, "missing"), dst))
+	entries, err = os.ReadDir(dst)
+	require.NoError(err)
+	require.Empty(entries)
+}
+
+func TestReplacePluginFile(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	dir := t.TempDir()
+	src := filepath.Join(dir, "new-vm")
+	dst := filepath.Join(dir, "vm")
+	require.NoError(os.WriteFile(src, []byte("new"), 0o644))
+	require.NoError(os.WriteFile(dst, []byte("old"), 0o755))
+	// keep the old plugin open, as a running node would
+	f, err := os.Open(dst)
+	require.NoError(err)
+	defer f.Close()
+
+	require.NoError(replacePluginFile(src, dst))
+	contents, err := os.ReadFile(dst)
+	require.NoError(err)
+	require.Equal([]byte("new"), contents)
+	info, err := os.Stat(dst)
+	require.NoError(err)
+	require.Equal(os.FileMode(0o755), info.Mode().Perm())
+	// the running plugin is unchanged
+	old := make([]byte, 3)
+	_, err = f.Read(old)
+	require.NoError(err)
+	require.Equal([]byte("old"), old)
+
+	require.Error(replacePluginFile(filepath.Join(dir, "missing"), dst))
+}
@@ -0,0 +1,47 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+var (
+	ErrUnknownAddressState = errors.New("unknown address state")
+
+	// bits of the camino P-chain address states, by name
+	AddressStates = map[string]uint8{
+		"admin":            txs.AddressStateRoleAdmin,
+		"kyc":              txs.AddressStateRoleKyc,
+		"kycVerified":      txs.AddressStateKycVerified,
+		"kycExpired":       txs.AddressStateKycExpired,
+		"consortiumMember": txs.AddressStateConsortium,
+		"nodeDeferred":     txs.AddressStateNodeDeferred,
+	}
+)
+
+// ParseAddressState returns the bit of the address state named [name].
+func ParseAddressState(name string) (uint8, error) {
+	state, ok := AddressStates[name]
+	if !ok {
+		return 0, fmt.Errorf("%w %q", ErrUnknownAddressState, name)
+	}
+	return state, nil
+}
+
+// AddressStateNames returns the names of the address states set in [states],
+// sorted by bit.
+func AddressStateNames(states uint64) []string {
+	names := []string{}
+	for name, state := range AddressStates {
+		if states&(uint64(1)<<state) != 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return AddressStates[names[i]] < AddressStates[names[j]]
+	})
+	return names
+}
@@ -0,0 +1,24 @@
+package network_test
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressStates(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	kycVerified, err := network.ParseAddressState("kycVerified")
+	require.NoError(err)
+	consortiumMember, err := network.ParseAddressState("consortiumMember")
+	require.NoError(err)
+	_, err = network.ParseAddressState("validator")
+	require.ErrorIs(err, network.ErrUnknownAddressState)
+
+	require.Empty(network.AddressStateNames(0))
+	states := uint64(1)<<consortiumMember | uint64(1)<<kycVerified | 1
+	require.Equal([]string{"admin", "kycVerified", "consortiumMember"}, network.AddressStateNames(states))
+}
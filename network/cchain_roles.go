@@ -0,0 +1,55 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// CChainDeployerRole names the permission to deploy contracts on the C-chain,
+// which camino gives to addresses with the KYC approved state of the admin
+// contract rather than with a role bit.
+const CChainDeployerRole = "deployer"
+
+var (
+	ErrUnknownCChainRole = errors.New("unknown C-chain role")
+
+	// bits of the roles of the camino C-chain admin contract, by name
+	CChainRoles = map[string]uint64{
+		"admin":     1 << 0,
+		"gasFee":    1 << 1,
+		"kyc":       1 << 2,
+		"blacklist": 1 << 3,
+	}
+)
+
+// ParseCChainRole returns the bit of the C-chain role named [name].
+// The deployer role has no bit, so 0 is returned for it.
+func ParseCChainRole(name string) (uint64, error) {
+	if name == CChainDeployerRole {
+		return 0, nil
+	}
+	role, ok := CChainRoles[name]
+	if !ok {
+		return 0, fmt.Errorf("%w %q", ErrUnknownCChainRole, name)
+	}
+	return role, nil
+}
+
+// CChainRoleNames returns the names of the C-chain roles set in [roles],
+// sorted by bit and followed by the deployer role if [deployer] is true.
+func CChainRoleNames(roles uint64, deployer bool) []string {
+	names := []string{}
+	for name, role := range CChainRoles {
+		if roles&role != 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return CChainRoles[names[i]] < CChainRoles[names[j]]
+	})
+	if deployer {
+		names = append(names, CChainDeployerRole)
+	}
+	return names
+}
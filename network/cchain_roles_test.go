@@ -0,0 +1,27 @@
+package network_test
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCChainRoles(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	kyc, err := network.ParseCChainRole("kyc")
+	require.NoError(err)
+	blacklist, err := network.ParseCChainRole("blacklist")
+	require.NoError(err)
+	deployer, err := network.ParseCChainRole(network.CChainDeployerRole)
+	require.NoError(err)
+	require.Zero(deployer)
+	_, err = network.ParseCChainRole("minter")
+	require.ErrorIs(err, network.ErrUnknownCChainRole)
+
+	require.Empty(network.CChainRoleNames(0, false))
+	require.Equal([]string{"deployer"}, network.CChainRoleNames(0, true))
+	require.Equal([]string{"admin", "kyc", "blacklist"}, network.CChainRoleNames(blacklist|kyc|1, false))
+}
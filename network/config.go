@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ava-labs/avalanche-network-runner/network/node"
@@ -13,6 +15,7 @@ import (
 	"github.com/ava-labs/avalanchego/genesis"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/utils/units"
 	"golang.org/x/exp/maps"
@@ -75,6 +78,157 @@ type Config struct {
 	UpgradeConfigFiles map[string]string `json:"upgradeConfigFiles"`
 	// Subnet config files to use per default, if not specified in node config
 	SubnetConfigFiles map[string]string `json:"subnetConfigFiles"`
+	// Range to allocate node ports from, if not specified in node config.
+	// The zero value means the default range.
+	PortRange PortRange `json:"portRange"`
+	// If true, each node runs in its own network namespace with a distinct
+	// private IP, instead of on 127.0.0.1. Linux only, requires root.
+	NodeNamespaces bool `json:"nodeNamespaces"`
+	// If true, node dbs of snapshots are saved to a content addressed store
+	// shared by all snapshots, with deduplicated and compressed chunks.
+	DedupSnapshots bool `json:"dedupSnapshots"`
+	// If true, the private keys given inline, [FundingKeys], [SubnetOwnerKeys],
+	// [AdminKey] and the node owner keys of [ValidatorBond], are saved in the
+	// snapshots of the network. Otherwise they are left out, and only the
+	// path of [FundingKeyFile] is saved.
+	SnapshotKeys bool `json:"snapshotKeys"`
+	// Bond of the validators added after genesis.
+	// The zero value means the minimum stake and the funding address.
+	ValidatorBond ValidatorBond `json:"validatorBond"`
+	// Private keys of the owners of subnets created by the runner, which it
+	// signs the txs modifying those subnets with.
+	SubnetOwnerKeys []string `json:"subnetOwnerKeys"`
+	// Private keys funding the P-chain txs of the runner, in
+	// "PrivateKey-..." format. The first one receives the change and is the
+	// default owner of rewards and subnets.
+	// If empty, and no key file is given, the EWOQ key of the local genesis.
+	FundingKeys []string `json:"fundingKeys"`
+	// Path of a file with further funding keys, one per line.
+	// Empty lines and lines starting with # are ignored.
+	FundingKeyFile string `json:"fundingKeyFile"`
+	// Private key holding the admin role of the P-chain and the C-chain, in
+	// "PrivateKey-..." format, which the runner signs the admin txs with.
+	// If empty, the initial admins of the local genesis: the VMRQ key on the
+	// P-chain and the EWOQ key on the C-chain.
+	AdminKey string `json:"adminKey"`
+	// Peers of an external network of [Genesis] joined by the nodes, instead
+	// of a network owned by the runner. If given, the nodes bootstrap from
+	// these peers and none needs to be a beacon.
+	ExternalPeers []node.BootstrapPeer `json:"externalPeers"`
+	// Activation times of network upgrades, set in [Genesis] when the
+	// network starts. Can't be given with [ExternalPeers].
+	UpgradeSchedule UpgradeSchedule `json:"upgradeSchedule"`
+}
+
+// GetAdminKey returns the key of [c.AdminKey], or nil if there is none.
+func (c *Config) GetAdminKey() (*secp256k1.PrivateKey, error) {
+	if c.AdminKey == "" {
+		return nil, nil
+	}
+	key, err := utils.ParsePrivateKey(c.AdminKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid admin key: %w", err)
+	}
+	return key, nil
+}
+
+// GetFundingKeys returns the keys of [c.FundingKeys] followed by those of
+// [c.FundingKeyFile], or nil if there are none.
+func (c *Config) GetFundingKeys() ([]*secp256k1.PrivateKey, error) {
+	keyStrs := c.FundingKeys
+	if c.FundingKeyFile != "" {
+		fileBytes, err := os.ReadFile(c.FundingKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't read funding key file: %w", err)
+		}
+		for _, line := range strings.Split(string(fileBytes), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			keyStrs = append(keyStrs, line)
+		}
+	}
+	var keys []*secp256k1.PrivateKey
+	known := map[ids.ShortID]struct{}{}
+	for i, keyStr := range keyStrs {
+		key, err := utils.ParsePrivateKey(keyStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid funding key %d: %w", i, err)
+		}
+		addr := key.PublicKey().Address()
+		if _, ok := known[addr]; ok {
+			return nil, fmt.Errorf("duplicated funding key %d", i)
+		}
+		known[addr] = struct{}{}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// ValidatorBond sets the stake of the nodes added as primary network validators.
+type ValidatorBond struct {
+	// Amount bonded by each validator, in nAVAX.
+	// If 0, the minimum validator stake of the network.
+	Amount uint64 `json:"amount"`
+	// Chain prefixed bech32 address receiving the validation rewards.
+	// If empty, the address funding the bond.
+	RewardsOwner string `json:"rewardsOwner"`
+	// Private keys of the consortium members the validators are registered
+	// to, on networks locking stakes as bonds, in "PrivateKey-..." format.
+	// As a consortium member owns a single node, each validator is
+	// registered to the first of them not owning a node yet.
+	// If empty, the funding keys.
+	NodeOwnerKeys []string `json:"nodeOwnerKeys"`
+}
+
+// GetNodeOwnerKeys returns the keys of [b.NodeOwnerKeys].
+func (b ValidatorBond) GetNodeOwnerKeys() ([]*secp256k1.PrivateKey, error) {
+	keys := make([]*secp256k1.PrivateKey, len(b.NodeOwnerKeys))
+	for i, keyStr := range b.NodeOwnerKeys {
+		key, err := utils.ParsePrivateKey(keyStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node owner key %d: %w", i, err)
+		}
+		keys[i] = key
+	}
+	return keys, nil
+}
+
+// Validate returns an error if the rewards owner is not a valid address,
+// or a node owner key is invalid
+func (b ValidatorBond) Validate() error {
+	if b.RewardsOwner != "" {
+		if _, _, _, err := address.Parse(b.RewardsOwner); err != nil {
+			return fmt.Errorf("invalid validator rewards owner %q: %w", b.RewardsOwner, err)
+		}
+	}
+	_, err := b.GetNodeOwnerKeys()
+	return err
+}
+
+// PortRange is an inclusive range of TCP ports.
+type PortRange struct {
+	Start uint16 `json:"start"`
+	End   uint16 `json:"end"`
+}
+
+// IsZero returns true if no range is set.
+func (r PortRange) IsZero() bool {
+	return r.Start == 0 && r.End == 0
+}
+
+// Validate returns an error if this range is set but invalid
+func (r PortRange) Validate() error {
+	switch {
+	case r.IsZero():
+		return nil
+	case r.Start == 0:
+		return errors.New("port range start must be positive")
+	case r.End < r.Start:
+		return fmt.Errorf("port range end %d is lower than start %d", r.End, r.Start)
+	}
+	return nil
 }
 
 // Validate returns an error if this config is invalid
@@ -88,6 +242,41 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("couldn't get network ID from genesis: %w", err)
 	}
 
+	if err := c.PortRange.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.ValidatorBond.Validate(); err != nil {
+		return err
+	}
+
+	for i, keyStr := range c.SubnetOwnerKeys {
+		if _, err := utils.ParsePrivateKey(keyStr); err != nil {
+			return fmt.Errorf("invalid subnet owner key %d: %w", i, err)
+		}
+	}
+
+	if _, err := c.GetFundingKeys(); err != nil {
+		return err
+	}
+
+	if _, err := c.GetAdminKey(); err != nil {
+		return err
+	}
+
+	for _, peer := range c.ExternalPeers {
+		if _, _, err := peer.Parse(); err != nil {
+			return err
+		}
+	}
+
+	if err := c.UpgradeSchedule.Validate(); err != nil {
+		return err
+	}
+	if !c.UpgradeSchedule.IsZero() && len(c.ExternalPeers) > 0 {
+		return errors.New("upgrade schedule can't be given for an external network")
+	}
+
 	var someNodeIsBeacon bool
 	for i, nodeConfig := range c.NodeConfigs {
 		if err := nodeConfig.Validate(networkID); err != nil {
@@ -103,7 +292,7 @@ func (c *Config) Validate() error {
 			someNodeIsBeacon = true
 		}
 	}
-	if len(c.NodeConfigs) > 0 && !someNodeIsBeacon {
+	if len(c.NodeConfigs) > 0 && len(c.ExternalPeers) == 0 && !someNodeIsBeacon {
 		return errors.New("beacon nodes not given")
 	}
 	return nil
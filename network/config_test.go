@@ -2,10 +2,14 @@ package network_test
 
 import (
 	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ava-labs/avalanche-network-runner/network"
 	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/stretchr/testify/require"
 )
 
@@ -58,3 +62,86 @@ func TestConfigMarshalJSON(t *testing.T) {
 
 	require.EqualValues(t, control, netcfg)
 }
+
+func TestValidatorBondValidate(t *testing.T) {
+	require := require.New(t)
+
+	require.NoError(network.ValidatorBond{}.Validate())
+	require.NoError(network.ValidatorBond{Amount: 1}.Validate())
+
+	rewardsOwner, err := address.Format("P", "custom", ids.GenerateTestShortID().Bytes())
+	require.NoError(err)
+	require.NoError(network.ValidatorBond{RewardsOwner: rewardsOwner}.Validate())
+
+	require.ErrorContains(network.ValidatorBond{RewardsOwner: "P-foo"}.Validate(), "invalid validator rewards owner")
+
+	bond := network.ValidatorBond{NodeOwnerKeys: []string{"PrivateKey-ewoqjP7PxY4yr3iLTpLisriqt94hdyDFNgchSxGGztUrTXtNN"}}
+	require.NoError(bond.Validate())
+	keys, err := bond.GetNodeOwnerKeys()
+	require.NoError(err)
+	require.Len(keys, 1)
+	bond.NodeOwnerKeys = append(bond.NodeOwnerKeys, "PrivateKey-foo")
+	require.ErrorContains(bond.Validate(), "invalid node owner key 1")
+}
+
+func TestGetFundingKeys(t *testing.T) {
+	require := require.New(t)
+
+	const (
+		ewoqKey = "PrivateKey-ewoqjP7PxY4yr3iLTpLisriqt94hdyDFNgchSxGGztUrTXtNN"
+		vmrqKey = "PrivateKey-vmRQiZeXEXYMyJhEiqdC2z5JhuDbxL8ix9UVvjgMu2Er1NepE"
+	)
+	keys, err := (&network.Config{}).GetFundingKeys()
+	require.NoError(err)
+	require.Empty(keys)
+
+	keyFile := filepath.Join(t.TempDir(), "keys")
+	require.NoError(os.WriteFile(keyFile, []byte("# treasury\n\n"+vmrqKey+"\n"), 0o600))
+	keys, err = (&network.Config{FundingKeys: []string{ewoqKey}, FundingKeyFile: keyFile}).GetFundingKeys()
+	require.NoError(err)
+	require.Len(keys, 2)
+	require.Equal(ewoqKey, keys[0].String())
+	require.Equal(vmrqKey, keys[1].String())
+
+	_, err = (&network.Config{FundingKeys: []string{vmrqKey}, FundingKeyFile: keyFile}).GetFundingKeys()
+	require.ErrorContains(err, "duplicated funding key 1")
+	_, err = (&network.Config{FundingKeys: []string{"PrivateKey-foo"}}).GetFundingKeys()
+	require.ErrorContains(err, "invalid funding key 0")
+	_, err = (&network.Config{FundingKeyFile: filepath.Join(t.TempDir(), "missing")}).GetFundingKeys()
+	require.ErrorContains(err, "couldn't read funding key file")
+}
+
+func TestGetAdminKey(t *testing.T) {
+	require := require.New(t)
+
+	const vmrqKey = "PrivateKey-vmRQiZeXEXYMyJhEiqdC2z5JhuDbxL8ix9UVvjgMu2Er1NepE"
+	key, err := (&network.Config{}).GetAdminKey()
+	require.NoError(err)
+	require.Nil(key)
+	key, err = (&network.Config{AdminKey: vmrqKey}).GetAdminKey()
+	require.NoError(err)
+	require.Equal(vmrqKey, key.String())
+	_, err = (&network.Config{AdminKey: "PrivateKey-foo"}).GetAdminKey()
+	require.ErrorContains(err, "invalid admin key")
+}
+
+func TestConfigValidateExternalPeers(t *testing.T) {
+	require := require.New(t)
+
+	config := network.Config{
+		Genesis:     `{"networkID": 1002}`,
+		NodeConfigs: []node.Config{{Name: "node1", StakingKey: "key", StakingCert: "cert"}},
+	}
+	require.ErrorContains(config.Validate(), "beacon nodes not given")
+
+	config.ExternalPeers = []node.BootstrapPeer{{ID: ids.GenerateTestNodeID().String(), IP: "127.0.0.1:9651"}}
+	require.NoError(config.Validate())
+
+	// the genesis of an external network can't be changed
+	config.UpgradeSchedule.CChainPhases = map[string]string{"sunrisePhase0BlockTimestamp": "10m"}
+	require.ErrorContains(config.Validate(), "upgrade schedule can't be given for an external network")
+	config.UpgradeSchedule = network.UpgradeSchedule{}
+
+	config.ExternalPeers[0].ID = "NodeID-foo"
+	require.ErrorContains(config.Validate(), "invalid bootstrap peer ID")
+}
@@ -0,0 +1,123 @@
+package network
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+)
+
+var (
+	ErrInvalidDepositOffer = errors.New("invalid deposit offer")
+	ErrNothingToClaim      = errors.New("no rewards to claim")
+	ErrNothingToUnlock     = errors.New("no deposited amount to unlock")
+)
+
+// ValidateDepositOffers returns an error if any of [offers] would be rejected
+// by the camino genesis.
+func ValidateDepositOffers(offers []genesis.UnparsedDepositOffer) error {
+	for i, offer := range offers {
+		if _, err := parseDepositOffer(offer, 0); err != nil {
+			return fmt.Errorf("%w %d (%q): %s", ErrInvalidDepositOffer, i, offer.Memo, err)
+		}
+	}
+	return nil
+}
+
+// ParseDepositOffers parses and validates the JSON list of camino genesis deposit
+// offers [offersJSON]. Returns no offers if [offersJSON] is empty.
+func ParseDepositOffers(offersJSON string) ([]genesis.UnparsedDepositOffer, error) {
+	if offersJSON == "" {
+		return nil, nil
+	}
+	offers := []genesis.UnparsedDepositOffer{}
+	if err := json.Unmarshal([]byte(offersJSON), &offers); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDepositOffer, err)
+	}
+	if err := ValidateDepositOffers(offers); err != nil {
+		return nil, err
+	}
+	return offers, nil
+}
+
+// AddDepositOffers appends [offers] to the deposit offers of the camino genesis
+// of this config. Offer times are offsets from the genesis start time.
+func (c *Config) AddDepositOffers(offers []genesis.UnparsedDepositOffer) error {
+	if len(offers) == 0 {
+		return nil
+	}
+	if err := ValidateDepositOffers(offers); err != nil {
+		return err
+	}
+	genesisMap := map[string]interface{}{}
+	if err := unmarshalWithNumbers([]byte(c.Genesis), &genesisMap); err != nil {
+		return fmt.Errorf("couldn't unmarshal genesis: %w", err)
+	}
+	camino, ok := genesisMap["camino"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("expected genesis camino to be a map, got %T", genesisMap["camino"])
+	}
+	depositOffers, _ := camino["depositOffers"].([]interface{})
+	for _, offer := range offers {
+		depositOffers = append(depositOffers, offer)
+	}
+	camino["depositOffers"] = depositOffers
+	genesisBytes, err := json.Marshal(genesisMap)
+	if err != nil {
+		return err
+	}
+	c.Genesis = string(genesisBytes)
+	return nil
+}
+
+// GetDepositOffers returns the deposit offers of the camino genesis [genesisBytes]
+// with their IDs, for a genesis started at unix time [startTime].
+func GetDepositOffers(genesisBytes []byte, startTime uint64) ([]*deposit.Offer, error) {
+	genesisConfig := struct {
+		Camino struct {
+			DepositOffers []genesis.UnparsedDepositOffer `json:"depositOffers"`
+		} `json:"camino"`
+	}{}
+	if err := json.Unmarshal(genesisBytes, &genesisConfig); err != nil {
+		return nil, fmt.Errorf("couldn't unmarshal genesis: %w", err)
+	}
+	offers := make([]*deposit.Offer, len(genesisConfig.Camino.DepositOffers))
+	for i, unparsedOffer := range genesisConfig.Camino.DepositOffers {
+		offer, err := parseDepositOffer(unparsedOffer, startTime)
+		if err != nil {
+			return nil, fmt.Errorf("%w %d (%q): %s", ErrInvalidDepositOffer, i, unparsedOffer.Memo, err)
+		}
+		offers[i] = offer
+	}
+	return offers, nil
+}
+
+// Returns the verified P-chain offer of the genesis [offer], as created by a
+// genesis started at unix time [startTime].
+func parseDepositOffer(unparsedOffer genesis.UnparsedDepositOffer, startTime uint64) (*deposit.Offer, error) {
+	genesisOffer, err := unparsedOffer.Parse(startTime)
+	if err != nil {
+		return nil, err
+	}
+	offer := &deposit.Offer{
+		InterestRateNominator:   genesisOffer.InterestRateNominator,
+		Start:                   genesisOffer.Start,
+		End:                     genesisOffer.End,
+		MinAmount:               genesisOffer.MinAmount,
+		MinDuration:             genesisOffer.MinDuration,
+		MaxDuration:             genesisOffer.MaxDuration,
+		UnlockPeriodDuration:    genesisOffer.UnlockPeriodDuration,
+		NoRewardsPeriodDuration: genesisOffer.NoRewardsPeriodDuration,
+		Memo:                    []byte(genesisOffer.Memo),
+		Flags:                   genesisOffer.Flags,
+	}
+	if err := offer.Verify(); err != nil {
+		return nil, err
+	}
+	if err := offer.SetID(); err != nil {
+		return nil, err
+	}
+	return offer, nil
+}
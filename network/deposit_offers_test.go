@@ -0,0 +1,53 @@
+package network_test
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositOffers(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	offers, err := network.ParseDepositOffers(`[{
+		"interestRateNominator": 100000,
+		"startOffset": 60,
+		"endOffset": 3600,
+		"minAmount": 1,
+		"minDuration": 60,
+		"maxDuration": 600,
+		"memo": "test offer"
+	}]`)
+	require.NoError(err)
+	require.Len(offers, 1)
+
+	cfg := network.Config{Genesis: `{"networkID":12345,"camino":{"lockModeBondDeposit":true,"depositOffers":[]}}`}
+	require.NoError(cfg.AddDepositOffers(offers))
+	require.Contains(cfg.Genesis, `"networkID":12345`)
+
+	const startTime = 1000
+	parsed, err := network.GetDepositOffers([]byte(cfg.Genesis), startTime)
+	require.NoError(err)
+	require.Len(parsed, 1)
+	require.Equal(uint64(startTime+60), parsed[0].Start)
+	require.Equal(uint64(startTime+3600), parsed[0].End)
+	require.Equal("test offer", string(parsed[0].Memo))
+	require.NotEmpty(parsed[0].ID)
+
+	// IDs only depend on the offer
+	again, err := network.GetDepositOffers([]byte(cfg.Genesis), startTime)
+	require.NoError(err)
+	require.Equal(parsed[0].ID, again[0].ID)
+
+	offers, err = network.ParseDepositOffers("")
+	require.NoError(err)
+	require.Empty(offers)
+
+	// max duration below min duration
+	_, err = network.ParseDepositOffers(`[{"minAmount": 1, "minDuration": 600, "maxDuration": 60, "endOffset": 3600}]`)
+	require.ErrorIs(err, network.ErrInvalidDepositOffer)
+	_, err = network.ParseDepositOffers(`{}`)
+	require.ErrorIs(err, network.ErrInvalidDepositOffer)
+}
@@ -0,0 +1,107 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanchego/ids"
+	avago_utils "github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+)
+
+var ErrInvalidOwners = errors.New("invalid owners")
+
+// SubnetOwner defines the owners of a subnet created by the runner,
+// instead of the funding key alone.
+type SubnetOwner struct {
+	// Number of owner signatures needed to modify the subnet
+	Threshold uint32
+	// Private keys of owners, in "PrivateKey-..." format.
+	// The runner signs the subnet txs it issues with them, so at least
+	// [Threshold] keys must be given.
+	Keys []string
+	// P-chain addresses of further owners, such as multisig aliases,
+	// which the runner doesn't sign for
+	Addresses []string
+}
+
+// Validate returns an error if [o] can't own a subnet managed by the runner.
+func (o SubnetOwner) Validate() error {
+	_, _, err := o.Parse()
+	return err
+}
+
+// Parse returns the keys and the sorted owner addresses of [o].
+func (o SubnetOwner) Parse() ([]*secp256k1.PrivateKey, []ids.ShortID, error) {
+	keys := make([]*secp256k1.PrivateKey, len(o.Keys))
+	addrs := make([]ids.ShortID, 0, len(o.Keys)+len(o.Addresses))
+	for i, keyStr := range o.Keys {
+		key, err := utils.ParsePrivateKey(keyStr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: key %d: %s", ErrInvalidOwners, i, err)
+		}
+		keys[i] = key
+		addrs = append(addrs, key.PublicKey().Address())
+	}
+	for _, addrStr := range o.Addresses {
+		addr, err := parseOwnerAddress(addrStr)
+		if err != nil {
+			return nil, nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	if err := verifyOwners(o.Threshold, addrs); err != nil {
+		return nil, nil, err
+	}
+	if uint32(len(keys)) < o.Threshold {
+		return nil, nil, fmt.Errorf("%w: %d keys can't meet threshold %d", ErrInvalidOwners, len(keys), o.Threshold)
+	}
+	avago_utils.Sort(addrs)
+	return keys, addrs, nil
+}
+
+// ParseMultisigAlias returns the sorted owner addresses of a multisig alias
+// owned by [threshold] of the P-chain addresses [addresses], which may be
+// aliases themselves.
+func ParseMultisigAlias(threshold uint32, addresses []string, memo string) ([]ids.ShortID, error) {
+	if len(memo) > multisig.MaxMemoSize {
+		return nil, fmt.Errorf("alias memo is larger (%d bytes) than max of %d bytes", len(memo), multisig.MaxMemoSize)
+	}
+	addrs := make([]ids.ShortID, len(addresses))
+	for i, addrStr := range addresses {
+		addr, err := parseOwnerAddress(addrStr)
+		if err != nil {
+			return nil, err
+		}
+		addrs[i] = addr
+	}
+	if err := verifyOwners(threshold, addrs); err != nil {
+		return nil, err
+	}
+	avago_utils.Sort(addrs)
+	return addrs, nil
+}
+
+func parseOwnerAddress(addrStr string) (ids.ShortID, error) {
+	_, _, addrBytes, err := address.Parse(addrStr)
+	if err != nil {
+		return ids.ShortEmpty, fmt.Errorf("%w: address %q: %s", ErrInvalidOwners, addrStr, err)
+	}
+	return ids.ToShortID(addrBytes)
+}
+
+// Returns an error if [threshold] of [addrs] can't be owners.
+func verifyOwners(threshold uint32, addrs []ids.ShortID) error {
+	switch {
+	case threshold == 0:
+		return fmt.Errorf("%w: threshold must be positive", ErrInvalidOwners)
+	case threshold > uint32(len(addrs)):
+		return fmt.Errorf("%w: threshold %d is above the %d owners", ErrInvalidOwners, threshold, len(addrs))
+	case !avago_utils.IsUnique(addrs):
+		return fmt.Errorf("%w: duplicated owners", ErrInvalidOwners)
+	}
+	return nil
+}
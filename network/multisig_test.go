@@ -0,0 +1,68 @@
+package network_test
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubnetOwner(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	factory := secp256k1.Factory{}
+	key1, err := factory.NewPrivateKey()
+	require.NoError(err)
+	key2, err := factory.NewPrivateKey()
+	require.NoError(err)
+	alias := ids.GenerateTestShortID()
+	aliasStr, err := address.Format("P", "kopernikus", alias.Bytes())
+	require.NoError(err)
+
+	owner := network.SubnetOwner{
+		Threshold: 2,
+		Keys:      []string{key1.String(), key2.String()},
+		Addresses: []string{aliasStr},
+	}
+	keys, addrs, err := owner.Parse()
+	require.NoError(err)
+	require.Len(keys, 2)
+	require.ElementsMatch([]ids.ShortID{key1.PublicKey().Address(), key2.PublicKey().Address(), alias}, addrs)
+	require.True(utils.IsSortedAndUniqueSortable(addrs))
+
+	// the runner can't sign for the alias
+	owner.Threshold = 3
+	require.ErrorIs(owner.Validate(), network.ErrInvalidOwners)
+	owner.Threshold = 0
+	require.ErrorIs(owner.Validate(), network.ErrInvalidOwners)
+	owner = network.SubnetOwner{Threshold: 1, Keys: []string{key1.String(), key1.String()}}
+	require.ErrorIs(owner.Validate(), network.ErrInvalidOwners)
+	owner = network.SubnetOwner{Threshold: 1, Keys: []string{"key"}}
+	require.ErrorIs(owner.Validate(), network.ErrInvalidOwners)
+}
+
+func TestParseMultisigAlias(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	addr1, err := address.Format("P", "kopernikus", ids.GenerateTestShortID().Bytes())
+	require.NoError(err)
+	addr2, err := address.Format("P", "kopernikus", ids.GenerateTestShortID().Bytes())
+	require.NoError(err)
+
+	addrs, err := network.ParseMultisigAlias(2, []string{addr1, addr2}, "alias")
+	require.NoError(err)
+	require.Len(addrs, 2)
+
+	_, err = network.ParseMultisigAlias(3, []string{addr1, addr2}, "")
+	require.ErrorIs(err, network.ErrInvalidOwners)
+	_, err = network.ParseMultisigAlias(1, []string{addr1, "P-foo"}, "")
+	require.ErrorIs(err, network.ErrInvalidOwners)
+	_, err = network.ParseMultisigAlias(1, []string{addr1}, string(make([]byte, 257)))
+	require.ErrorContains(err, "memo")
+}
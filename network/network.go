@@ -3,20 +3,58 @@ package network
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/utils/fastcopy"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/exp/slices"
 )
 
 var (
-	ErrUndefined    = errors.New("undefined network")
-	ErrStopped      = errors.New("network stopped")
-	ErrNodeNotFound = errors.New("node not found in network")
+	ErrUndefined     = errors.New("undefined network")
+	ErrStopped       = errors.New("network stopped")
+	ErrNodeNotFound  = errors.New("node not found in network")
+	ErrInvalidWeight = errors.New("invalid participant weight")
 )
 
+// Validator weight of subnet participants without a weight of their own
+const DefaultSubnetValidatorWeight = 1000
+
 type SubnetSpec struct {
 	Participants []string
 	SubnetConfig []byte
+	// If nil, the subnet is owned by the funding key alone
+	Owner *SubnetOwner
+	// Validator weight of participants, by name.
+	// Participants not in the map get DefaultSubnetValidatorWeight.
+	ParticipantWeights map[string]uint64
+}
+
+// ValidateParticipantWeights returns an error if a participant weight of [s]
+// is zero or given for a node that isn't a participant.
+// If [s] has no participants, any node can be weighted.
+func (s SubnetSpec) ValidateParticipantWeights() error {
+	for nodeName, weight := range s.ParticipantWeights {
+		if weight == 0 {
+			return fmt.Errorf("%w: zero weight for %q", ErrInvalidWeight, nodeName)
+		}
+		if len(s.Participants) > 0 && !slices.Contains(s.Participants, nodeName) {
+			return fmt.Errorf("%w: %q is not a participant", ErrInvalidWeight, nodeName)
+		}
+	}
+	return nil
+}
+
+// GetParticipantWeight returns the validator weight of the participant [nodeName].
+func (s SubnetSpec) GetParticipantWeight(nodeName string) uint64 {
+	if weight, ok := s.ParticipantWeights[nodeName]; ok {
+		return weight
+	}
+	return DefaultSubnetValidatorWeight
 }
 
 type BlockchainSpec struct {
@@ -30,6 +68,22 @@ type BlockchainSpec struct {
 	PerNodeChainConfig map[string][]byte
 }
 
+// DelegatorSpec defines a delegation to a validator of the network.
+type DelegatorSpec struct {
+	// Subnet of the validation delegated to, the primary network if empty.
+	// Other subnets must be elastic.
+	SubnetID ids.ID
+	// Delegated amount, in nAVAX or units of the subnet staking asset.
+	// If 0, the minimum delegator stake of the subnet.
+	Amount uint64
+	// Time from now to the delegation end.
+	// If 0, until the end of the validation.
+	Duration time.Duration
+	// P-chain address receiving the rewards.
+	// If empty, the address of the first funding key.
+	RewardsOwner string
+}
+
 // Network is an abstraction of an Avalanche network
 type Network interface {
 	// Returns nil if all the nodes in the network are healthy.
@@ -65,6 +119,10 @@ type Network interface {
 	// Network is stopped in order to do a safe preservation
 	// Returns the full local path to the snapshot dir
 	SaveSnapshot(context.Context, string) (string, error)
+	// Returns how the db dir of each node was copied by the last snapshot
+	// save or load, by node name. Empty if the snapshot store was used.
+	// Available after the network is stopped.
+	GetSnapshotDBCopies() map[string]fastcopy.Result
 	// Remove network snapshot
 	RemoveSnapshot(string) error
 	// Get name of available snapshots
@@ -77,4 +135,61 @@ type Network interface {
 	CreateBlockchains(context.Context, []BlockchainSpec) ([]ids.ID, error)
 	// Create the given numbers of subnets
 	CreateSubnets(context.Context, []SubnetSpec) ([]ids.ID, error)
+	// Replace the plugin of the given VM by the given plugin binary, restarting
+	// the nodes tracking the subnets running the VM.
+	// Returns the names of the restarted nodes.
+	ReplaceVMPlugin(context.Context, ids.ID, string) ([]string, error)
+	// Set the config of the given blockchain, optionally per node, on the
+	// nodes tracking its subnet, restarting the ones whose config changed.
+	// Returns the names of the restarted nodes.
+	UpdateChainConfig(context.Context, ids.ID, []byte, map[string][]byte) ([]string, error)
+	// Set the config of the given subnet on the nodes tracking it,
+	// restarting the ones whose config changed.
+	// Returns the names of the restarted nodes.
+	UpdateSubnetConfig(context.Context, ids.ID, []byte) ([]string, error)
+	// Returns the C-chain phases and P-chain upgrades of the network,
+	// sorted by activation time.
+	GetUpgrades() ([]Upgrade, error)
+	// Returns the peers of the external network the nodes joined, or nil if
+	// the network is owned by the runner.
+	GetExternalPeers() ([]node.BootstrapPeer, error)
+	// Set the given address state bit on the given P-chain address, or unset
+	// it if remove is true, with a tx signed by the genesis admin key.
+	// Returns the ID of the tx.
+	SetAddressState(context.Context, string, uint8, bool) (ids.ID, error)
+	// Returns the address state bits of the given P-chain address.
+	GetAddressState(context.Context, string) (uint64, error)
+	// Returns the deposit offers active at the current P-chain time.
+	GetDepositOffers(context.Context) ([]*deposit.Offer, error)
+	// Deposits the given amount with the given offer for the given duration
+	// in seconds, from the funds of the funding key.
+	// Returns the ID of the deposit tx.
+	Deposit(context.Context, ids.ID, uint64, uint32) (ids.ID, error)
+	// Claims the rewards of the given deposits, and the validator and expired
+	// deposit rewards of the funding key, to the funding key.
+	// Returns the ID of the claim tx and the claimed amount.
+	ClaimRewards(context.Context, []ids.ID) (ids.ID, uint64, error)
+	// Unlocks the amounts of the given deposits that are unlockable at the
+	// current P-chain time.
+	// Returns the ID of the unlock tx and the unlocked amount.
+	UnlockDeposit(context.Context, []ids.ID) (ids.ID, uint64, error)
+	// Creates a multisig alias owned by the given threshold of the given
+	// P-chain addresses, with the given memo.
+	// Returns the ID of the tx and the P-chain address of the alias.
+	CreateMultisigAlias(context.Context, uint32, []string, string) (ids.ID, string, error)
+	// Grants the given C-chain role bits to the given C-chain address, or
+	// revokes them if revoke is true, with a tx signed by the genesis admin key.
+	// Returns the hash of the tx.
+	SetCChainRole(context.Context, string, uint64, bool) (common.Hash, error)
+	// Allows the given C-chain address to deploy contracts, or disallows it if
+	// allowed is false, with a tx signed by the genesis admin key.
+	// Returns the hash of the tx.
+	SetCChainDeployer(context.Context, string, bool) (common.Hash, error)
+	// Returns the C-chain role bits of the given C-chain address, and whether
+	// it is allowed to deploy contracts.
+	GetCChainRoles(context.Context, string) (uint64, bool, error)
+	// Delegates to the validator with the given node name, as given by the
+	// spec, from the funds of the funding keys.
+	// Returns the ID of the delegator tx.
+	AddDelegator(context.Context, string, DelegatorSpec) (ids.ID, error)
 }
@@ -0,0 +1,29 @@
+package network_test
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubnetParticipantWeights(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	spec := network.SubnetSpec{
+		Participants:       []string{"node1", "node2"},
+		ParticipantWeights: map[string]uint64{"node1": 5_000},
+	}
+	require.NoError(spec.ValidateParticipantWeights())
+	require.Equal(uint64(5_000), spec.GetParticipantWeight("node1"))
+	require.Equal(uint64(network.DefaultSubnetValidatorWeight), spec.GetParticipantWeight("node2"))
+
+	spec.ParticipantWeights["node3"] = 1
+	require.ErrorIs(spec.ValidateParticipantWeights(), network.ErrInvalidWeight)
+	// any node can be weighted if all nodes participate
+	spec.Participants = nil
+	require.NoError(spec.ValidateParticipantWeights())
+	spec.ParticipantWeights["node2"] = 0
+	require.ErrorIs(spec.ValidateParticipantWeights(), network.ErrInvalidWeight)
+}
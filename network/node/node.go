@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ava-labs/avalanche-network-runner/api"
 	"github.com/ava-labs/avalanche-network-runner/network/node/status"
@@ -12,6 +13,8 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
+	"github.com/ava-labs/avalanchego/utils/ips"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 )
 
 // Node represents an Camino node
@@ -57,6 +60,8 @@ type Node interface {
 	GetFlag(string) (string, error)
 	// Return this node's paused status
 	GetPaused() bool
+	// Return the peers this node was started to bootstrap from
+	GetBootstrapPeers() []BootstrapPeer
 }
 
 // Config encapsulates an camino node configuration
@@ -95,6 +100,107 @@ type Config struct {
 	RedirectStdout bool `json:"redirectStdout"`
 	// If non-nil, direct this node's Stderr to os.Stderr
 	RedirectStderr bool `json:"redirectStderr"`
+	// How the node validates the primary network if it is added as
+	// validator after genesis
+	Validator ValidatorConfig `json:"validator"`
+	// Role of the node in the network. If empty, ValidatorRole.
+	Role Role `json:"role"`
+	// Names of the nodes of the network to bootstrap from, which must be
+	// running. If this and BootstrapPeers are empty, the node bootstraps
+	// from the beacons of the network.
+	BootstrapNodes []string `json:"bootstrapNodes"`
+	// Peers outside the network to bootstrap from, besides BootstrapNodes
+	BootstrapPeers []BootstrapPeer `json:"bootstrapPeers"`
+}
+
+// BootstrapPeer is a peer a node bootstraps from
+type BootstrapPeer struct {
+	// Name of the peer in the network, empty for peers outside of it
+	Name string `json:"name,omitempty"`
+	// Node ID, such as "NodeID-..."
+	ID string `json:"id"`
+	// IP and staking port, such as "127.0.0.1:9651"
+	IP string `json:"ip"`
+}
+
+// Parse returns the node ID and the IP of [p].
+func (p BootstrapPeer) Parse() (ids.NodeID, ips.IPPort, error) {
+	nodeID, err := ids.NodeIDFromString(p.ID)
+	if err != nil {
+		return ids.EmptyNodeID, ips.IPPort{}, fmt.Errorf("invalid bootstrap peer ID %q: %w", p.ID, err)
+	}
+	ip, err := ips.ToIPPort(p.IP)
+	if err != nil {
+		return ids.EmptyNodeID, ips.IPPort{}, fmt.Errorf("invalid bootstrap peer IP %q: %w", p.IP, err)
+	}
+	return nodeID, ip, nil
+}
+
+// Role of a node in the network
+type Role string
+
+const (
+	// Node staked as primary network validator when added after genesis
+	ValidatorRole Role = "validator"
+	// Node serving APIs without staking
+	APIRole Role = "api"
+	// API node keeping the whole C-chain state and indexing the accepted
+	// containers
+	ArchivalRole Role = "archival"
+)
+
+// Roles a node can have
+var Roles = []Role{ValidatorRole, APIRole, ArchivalRole}
+
+// Validate returns an error if [r] isn't a known role or empty
+func (r Role) Validate() error {
+	if r == "" {
+		return nil
+	}
+	for _, role := range Roles {
+		if r == role {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown node role %q", r)
+}
+
+// IsValidator returns true if a node with role [r] validates the network
+func (r Role) IsValidator() bool {
+	return r == "" || r == ValidatorRole
+}
+
+// String returns the role name, ValidatorRole if empty
+func (r Role) String() string {
+	if r == "" {
+		return string(ValidatorRole)
+	}
+	return string(r)
+}
+
+// ValidatorConfig sets how a node added after genesis validates the primary
+// network. Zero values mean the network defaults.
+type ValidatorConfig struct {
+	// nAVAX staked, or bonded on camino networks.
+	// If 0, the validator bond amount of the network.
+	Stake uint64 `json:"stake"`
+	// Fee charged to delegators, in parts per million.
+	// If nil, 10%. Not used on camino networks, which take no delegations.
+	DelegationFee *uint32 `json:"delegationFee,omitempty"`
+	// Time from when the node is added as validator to the validation end.
+	// If 0, a year.
+	Duration time.Duration `json:"duration"`
+}
+
+// Validate returns an error if this config is invalid
+func (c ValidatorConfig) Validate() error {
+	switch {
+	case c.DelegationFee != nil && *c.DelegationFee > reward.PercentDenominator:
+		return fmt.Errorf("delegation fee %d is above %d", *c.DelegationFee, reward.PercentDenominator)
+	case c.Duration < 0:
+		return fmt.Errorf("negative validation duration %s", c.Duration)
+	}
+	return nil
 }
 
 // Validate returns an error if this config is invalid
@@ -104,9 +210,19 @@ func (c *Config) Validate(expectedNetworkID uint32) error {
 		return errors.New("staking key not given")
 	case c.StakingCert == "":
 		return errors.New("staking cert not given")
-	default:
-		return validateConfigFile([]byte(c.ConfigFile), expectedNetworkID)
 	}
+	if err := c.Validator.Validate(); err != nil {
+		return err
+	}
+	if err := c.Role.Validate(); err != nil {
+		return err
+	}
+	for _, peer := range c.BootstrapPeers {
+		if _, _, err := peer.Parse(); err != nil {
+			return err
+		}
+	}
+	return validateConfigFile([]byte(c.ConfigFile), expectedNetworkID)
 }
 
 // Returns an error if config file [configFile] is invalid.
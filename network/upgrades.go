@@ -0,0 +1,191 @@
+package network
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	coreth_params "github.com/ava-labs/coreth/params"
+)
+
+const (
+	CChainAlias = "C"
+
+	corethPhaseSuffix = "Timestamp"
+)
+
+var (
+	ErrInvalidUpgradeTime = errors.New("invalid upgrade time")
+	ErrUnknownPhase       = errors.New("unknown C-chain phase")
+
+	// json names of the timestamps of the coreth chain config
+	corethPhases = map[string]struct{}{}
+)
+
+func init() {
+	t := reflect.TypeOf(coreth_params.ChainConfig{})
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if strings.HasSuffix(name, corethPhaseSuffix) {
+			corethPhases[name] = struct{}{}
+		}
+	}
+}
+
+// UpgradeSchedule sets when network upgrades activate. Times are given either
+// in RFC3339 format or as an offset from the network start, such as "10m".
+// P-chain upgrade times are fixed by the node binary for each network ID, so
+// only C-chain phases, set in the C-chain genesis, can be scheduled.
+type UpgradeSchedule struct {
+	// C-chain phases by the name of their coreth chain config timestamp,
+	// such as "sunrisePhase0BlockTimestamp".
+	CChainPhases map[string]string `json:"cChainPhases,omitempty"`
+}
+
+// IsZero returns true if no upgrade is scheduled.
+func (s UpgradeSchedule) IsZero() bool {
+	return len(s.CChainPhases) == 0
+}
+
+// Upgrade is a scheduled network upgrade.
+type Upgrade struct {
+	// [CChainAlias]
+	Chain string
+	Name  string
+	Time  time.Time
+}
+
+// IsActive returns true if the upgrade is active at [now].
+func (u Upgrade) IsActive(now time.Time) bool {
+	return !now.Before(u.Time)
+}
+
+// ParseUpgradeTime returns the time given by [s], either in RFC3339 format
+// or as an offset from [start].
+func ParseUpgradeTime(s string, start time.Time) (time.Time, error) {
+	if offset, err := time.ParseDuration(strings.TrimPrefix(s, "+")); err == nil {
+		return start.Add(offset), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w %q: expected RFC3339 time or offset", ErrInvalidUpgradeTime, s)
+	}
+	return t, nil
+}
+
+// Validate returns an error if a phase is unknown or a time is invalid.
+func (s UpgradeSchedule) Validate() error {
+	for name, timeStr := range s.CChainPhases {
+		if _, ok := corethPhases[name]; !ok {
+			return fmt.Errorf("%w %q", ErrUnknownPhase, name)
+		}
+		if _, err := ParseUpgradeTime(timeStr, time.Time{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplyUpgradeSchedule sets the times of [c.UpgradeSchedule] in the genesis
+// of this config, resolving offsets from [start].
+func (c *Config) ApplyUpgradeSchedule(start time.Time) error {
+	schedule := c.UpgradeSchedule
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+	if len(schedule.CChainPhases) > 0 {
+		genesisMap, cChainGenesisMap, err := parseCChainGenesis([]byte(c.Genesis))
+		if err != nil {
+			return err
+		}
+		chainConfig, ok := cChainGenesisMap["config"].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("expected C-chain genesis config to be a map, got %T", cChainGenesisMap["config"])
+		}
+		for name, timeStr := range schedule.CChainPhases {
+			t, err := ParseUpgradeTime(timeStr, start)
+			if err != nil {
+				return err
+			}
+			chainConfig[name] = t.Unix()
+		}
+		cChainGenesisBytes, err := json.Marshal(cChainGenesisMap)
+		if err != nil {
+			return err
+		}
+		genesisMap["cChainGenesis"] = string(cChainGenesisBytes)
+		genesisBytes, err := json.Marshal(genesisMap)
+		if err != nil {
+			return err
+		}
+		c.Genesis = string(genesisBytes)
+	}
+	return nil
+}
+
+// GetUpgrades returns the C-chain phases set in [genesis], sorted by time.
+func GetUpgrades(genesis []byte) ([]Upgrade, error) {
+	upgrades := []Upgrade{}
+	genesisMap := map[string]interface{}{}
+	if err := json.Unmarshal(genesis, &genesisMap); err != nil {
+		return nil, fmt.Errorf("couldn't unmarshal genesis: %w", err)
+	}
+	chainConfig := map[string]interface{}{}
+	if _, ok := genesisMap["cChainGenesis"]; ok {
+		_, cChainGenesisMap, err := parseCChainGenesis(genesis)
+		if err != nil {
+			return nil, err
+		}
+		chainConfig, _ = cChainGenesisMap["config"].(map[string]interface{})
+	}
+	for name, v := range chainConfig {
+		if !strings.HasSuffix(name, corethPhaseSuffix) {
+			continue
+		}
+		n, ok := v.(json.Number)
+		if !ok {
+			continue
+		}
+		unixTime, err := n.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("%w for %q: %s", ErrInvalidUpgradeTime, name, err)
+		}
+		upgrades = append(upgrades, Upgrade{Chain: CChainAlias, Name: name, Time: time.Unix(unixTime, 0).UTC()})
+	}
+	sort.Slice(upgrades, func(i, j int) bool {
+		if !upgrades[i].Time.Equal(upgrades[j].Time) {
+			return upgrades[i].Time.Before(upgrades[j].Time)
+		}
+		return upgrades[i].Name < upgrades[j].Name
+	})
+	return upgrades, nil
+}
+
+// Returns the map of [genesis] and of its C-chain genesis.
+// Numbers are kept as [json.Number], to not lose precision on amounts.
+func parseCChainGenesis(genesis []byte) (map[string]interface{}, map[string]interface{}, error) {
+	genesisMap := map[string]interface{}{}
+	if err := unmarshalWithNumbers(genesis, &genesisMap); err != nil {
+		return nil, nil, fmt.Errorf("couldn't unmarshal genesis: %w", err)
+	}
+	cChainGenesis, ok := genesisMap["cChainGenesis"].(string)
+	if !ok {
+		return nil, nil, fmt.Errorf("expected cChainGenesis to be a string, but got %T", genesisMap["cChainGenesis"])
+	}
+	cChainGenesisMap := map[string]interface{}{}
+	if err := unmarshalWithNumbers([]byte(cChainGenesis), &cChainGenesisMap); err != nil {
+		return nil, nil, fmt.Errorf("couldn't unmarshal C-chain genesis: %w", err)
+	}
+	return genesisMap, cChainGenesisMap, nil
+}
+
+func unmarshalWithNumbers(b []byte, v interface{}) error {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	return d.Decode(v)
+}
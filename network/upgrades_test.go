@@ -0,0 +1,74 @@
+package network_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUpgradeTime(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	start := time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC)
+	for s, expected := range map[string]time.Time{
+		"10m":                  start.Add(10 * time.Minute),
+		"+1h30m":               start.Add(90 * time.Minute),
+		"0s":                   start,
+		"2023-07-01T00:00:00Z": time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		parsed, err := network.ParseUpgradeTime(s, start)
+		require.NoError(err)
+		require.True(expected.Equal(parsed), s)
+	}
+	_, err := network.ParseUpgradeTime("tomorrow", start)
+	require.ErrorIs(err, network.ErrInvalidUpgradeTime)
+}
+
+func TestApplyUpgradeSchedule(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	genesisMap, err := network.LoadLocalGenesis()
+	require.NoError(err)
+	genesis, err := json.Marshal(genesisMap)
+	require.NoError(err)
+	cfg := network.Config{
+		Genesis: string(genesis),
+		UpgradeSchedule: network.UpgradeSchedule{
+			CChainPhases: map[string]string{
+				"sunrisePhase0BlockTimestamp": "10m",
+			},
+		},
+	}
+
+	start := time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(cfg.ApplyUpgradeSchedule(start))
+
+	upgrades, err := network.GetUpgrades([]byte(cfg.Genesis))
+	require.NoError(err)
+	scheduled := map[string]network.Upgrade{}
+	for _, upgrade := range upgrades {
+		scheduled[upgrade.Chain+"/"+upgrade.Name] = upgrade
+	}
+	sunrise := scheduled["C/sunrisePhase0BlockTimestamp"]
+	require.True(start.Add(10 * time.Minute).Equal(sunrise.Time))
+	require.False(sunrise.IsActive(start))
+	require.True(sunrise.IsActive(start.Add(10 * time.Minute)))
+	// the local genesis activates the other phases at genesis
+	require.True(scheduled["C/banffBlockTimestamp"].IsActive(start))
+	// sorted by time
+	require.Equal(sunrise, upgrades[len(upgrades)-1])
+
+	// amounts of the genesis are kept
+	updatedGenesisMap := map[string]interface{}{}
+	require.NoError(json.Unmarshal([]byte(cfg.Genesis), &updatedGenesisMap))
+	require.Equal(genesisMap["allocations"], updatedGenesisMap["allocations"])
+
+	cfg.UpgradeSchedule.CChainPhases = map[string]string{"homesteadBlock": "0s"}
+	require.ErrorIs(cfg.ApplyUpgradeSchedule(start), network.ErrUnknownPhase)
+	require.ErrorIs(cfg.Validate(), network.ErrUnknownPhase)
+}
@@ -111,6 +111,7 @@ var PingService_ServiceDesc = grpc.ServiceDesc{
 const (
 	ControlService_RPCVersion_FullMethodName          = "/rpcpb.ControlService/RPCVersion"
 	ControlService_Start_FullMethodName               = "/rpcpb.ControlService/Start"
+	ControlService_JoinExternalNetwork_FullMethodName = "/rpcpb.ControlService/JoinExternalNetwork"
 	ControlService_CreateBlockchains_FullMethodName   = "/rpcpb.ControlService/CreateBlockchains"
 	ControlService_CreateSubnets_FullMethodName       = "/rpcpb.ControlService/CreateSubnets"
 	ControlService_Health_FullMethodName              = "/rpcpb.ControlService/Health"
@@ -130,6 +131,25 @@ const (
 	ControlService_LoadSnapshot_FullMethodName        = "/rpcpb.ControlService/LoadSnapshot"
 	ControlService_RemoveSnapshot_FullMethodName      = "/rpcpb.ControlService/RemoveSnapshot"
 	ControlService_GetSnapshotNames_FullMethodName    = "/rpcpb.ControlService/GetSnapshotNames"
+	ControlService_MigrateSnapshot_FullMethodName     = "/rpcpb.ControlService/MigrateSnapshot"
+	ControlService_RegisterBinary_FullMethodName      = "/rpcpb.ControlService/RegisterBinary"
+	ControlService_ListBinaries_FullMethodName        = "/rpcpb.ControlService/ListBinaries"
+	ControlService_RemoveBinary_FullMethodName        = "/rpcpb.ControlService/RemoveBinary"
+	ControlService_UploadArtifact_FullMethodName      = "/rpcpb.ControlService/UploadArtifact"
+	ControlService_ReplaceVMPlugin_FullMethodName     = "/rpcpb.ControlService/ReplaceVMPlugin"
+	ControlService_UpdateChainConfig_FullMethodName   = "/rpcpb.ControlService/UpdateChainConfig"
+	ControlService_UpdateSubnetConfig_FullMethodName  = "/rpcpb.ControlService/UpdateSubnetConfig"
+	ControlService_SetAddressState_FullMethodName     = "/rpcpb.ControlService/SetAddressState"
+	ControlService_GetAddressState_FullMethodName     = "/rpcpb.ControlService/GetAddressState"
+	ControlService_GetDepositOffers_FullMethodName    = "/rpcpb.ControlService/GetDepositOffers"
+	ControlService_Deposit_FullMethodName             = "/rpcpb.ControlService/Deposit"
+	ControlService_ClaimRewards_FullMethodName        = "/rpcpb.ControlService/ClaimRewards"
+	ControlService_UnlockDeposit_FullMethodName       = "/rpcpb.ControlService/UnlockDeposit"
+	ControlService_CreateMultisigAlias_FullMethodName = "/rpcpb.ControlService/CreateMultisigAlias"
+	ControlService_GrantCChainRole_FullMethodName     = "/rpcpb.ControlService/GrantCChainRole"
+	ControlService_RevokeCChainRole_FullMethodName    = "/rpcpb.ControlService/RevokeCChainRole"
+	ControlService_GetCChainRoles_FullMethodName      = "/rpcpb.ControlService/GetCChainRoles"
+	ControlService_AddDelegator_FullMethodName        = "/rpcpb.ControlService/AddDelegator"
 )
 
 // ControlServiceClient is the client API for ControlService service.
@@ -138,6 +158,7 @@ const (
 type ControlServiceClient interface {
 	RPCVersion(ctx context.Context, in *RPCVersionRequest, opts ...grpc.CallOption) (*RPCVersionResponse, error)
 	Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error)
+	JoinExternalNetwork(ctx context.Context, in *JoinExternalNetworkRequest, opts ...grpc.CallOption) (*JoinExternalNetworkResponse, error)
 	CreateBlockchains(ctx context.Context, in *CreateBlockchainsRequest, opts ...grpc.CallOption) (*CreateBlockchainsResponse, error)
 	CreateSubnets(ctx context.Context, in *CreateSubnetsRequest, opts ...grpc.CallOption) (*CreateSubnetsResponse, error)
 	Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
@@ -157,6 +178,25 @@ type ControlServiceClient interface {
 	LoadSnapshot(ctx context.Context, in *LoadSnapshotRequest, opts ...grpc.CallOption) (*LoadSnapshotResponse, error)
 	RemoveSnapshot(ctx context.Context, in *RemoveSnapshotRequest, opts ...grpc.CallOption) (*RemoveSnapshotResponse, error)
 	GetSnapshotNames(ctx context.Context, in *GetSnapshotNamesRequest, opts ...grpc.CallOption) (*GetSnapshotNamesResponse, error)
+	MigrateSnapshot(ctx context.Context, in *MigrateSnapshotRequest, opts ...grpc.CallOption) (*MigrateSnapshotResponse, error)
+	RegisterBinary(ctx context.Context, in *RegisterBinaryRequest, opts ...grpc.CallOption) (*RegisterBinaryResponse, error)
+	ListBinaries(ctx context.Context, in *ListBinariesRequest, opts ...grpc.CallOption) (*ListBinariesResponse, error)
+	RemoveBinary(ctx context.Context, in *RemoveBinaryRequest, opts ...grpc.CallOption) (*RemoveBinaryResponse, error)
+	UploadArtifact(ctx context.Context, opts ...grpc.CallOption) (ControlService_UploadArtifactClient, error)
+	ReplaceVMPlugin(ctx context.Context, in *ReplaceVMPluginRequest, opts ...grpc.CallOption) (*ReplaceVMPluginResponse, error)
+	UpdateChainConfig(ctx context.Context, in *UpdateChainConfigRequest, opts ...grpc.CallOption) (*UpdateChainConfigResponse, error)
+	UpdateSubnetConfig(ctx context.Context, in *UpdateSubnetConfigRequest, opts ...grpc.CallOption) (*UpdateSubnetConfigResponse, error)
+	SetAddressState(ctx context.Context, in *SetAddressStateRequest, opts ...grpc.CallOption) (*SetAddressStateResponse, error)
+	GetAddressState(ctx context.Context, in *GetAddressStateRequest, opts ...grpc.CallOption) (*GetAddressStateResponse, error)
+	GetDepositOffers(ctx context.Context, in *GetDepositOffersRequest, opts ...grpc.CallOption) (*GetDepositOffersResponse, error)
+	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error)
+	ClaimRewards(ctx context.Context, in *ClaimRewardsRequest, opts ...grpc.CallOption) (*ClaimRewardsResponse, error)
+	UnlockDeposit(ctx context.Context, in *UnlockDepositRequest, opts ...grpc.CallOption) (*UnlockDepositResponse, error)
+	CreateMultisigAlias(ctx context.Context, in *CreateMultisigAliasRequest, opts ...grpc.CallOption) (*CreateMultisigAliasResponse, error)
+	GrantCChainRole(ctx context.Context, in *CChainRoleRequest, opts ...grpc.CallOption) (*CChainRoleResponse, error)
+	RevokeCChainRole(ctx context.Context, in *CChainRoleRequest, opts ...grpc.CallOption) (*CChainRoleResponse, error)
+	GetCChainRoles(ctx context.Context, in *GetCChainRolesRequest, opts ...grpc.CallOption) (*GetCChainRolesResponse, error)
+	AddDelegator(ctx context.Context, in *AddDelegatorRequest, opts ...grpc.CallOption) (*AddDelegatorResponse, error)
 }
 
 type controlServiceClient struct {
@@ -185,6 +225,15 @@ func (c *controlServiceClient) Start(ctx context.Context, in *StartRequest, opts
 	return out, nil
 }
 
+func (c *controlServiceClient) JoinExternalNetwork(ctx context.Context, in *JoinExternalNetworkRequest, opts ...grpc.CallOption) (*JoinExternalNetworkResponse, error) {
+	out := new(JoinExternalNetworkResponse)
+	err := c.cc.Invoke(ctx, ControlService_JoinExternalNetwork_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlServiceClient) CreateBlockchains(ctx context.Context, in *CreateBlockchainsRequest, opts ...grpc.CallOption) (*CreateBlockchainsResponse, error) {
 	out := new(CreateBlockchainsResponse)
 	err := c.cc.Invoke(ctx, ControlService_CreateBlockchains_FullMethodName, in, out, opts...)
@@ -379,12 +428,209 @@ func (c *controlServiceClient) GetSnapshotNames(ctx context.Context, in *GetSnap
 	return out, nil
 }
 
+func (c *controlServiceClient) MigrateSnapshot(ctx context.Context, in *MigrateSnapshotRequest, opts ...grpc.CallOption) (*MigrateSnapshotResponse, error) {
+	out := new(MigrateSnapshotResponse)
+	err := c.cc.Invoke(ctx, ControlService_MigrateSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) RegisterBinary(ctx context.Context, in *RegisterBinaryRequest, opts ...grpc.CallOption) (*RegisterBinaryResponse, error) {
+	out := new(RegisterBinaryResponse)
+	err := c.cc.Invoke(ctx, ControlService_RegisterBinary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) ListBinaries(ctx context.Context, in *ListBinariesRequest, opts ...grpc.CallOption) (*ListBinariesResponse, error) {
+	out := new(ListBinariesResponse)
+	err := c.cc.Invoke(ctx, ControlService_ListBinaries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) RemoveBinary(ctx context.Context, in *RemoveBinaryRequest, opts ...grpc.CallOption) (*RemoveBinaryResponse, error) {
+	out := new(RemoveBinaryResponse)
+	err := c.cc.Invoke(ctx, ControlService_RemoveBinary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) UploadArtifact(ctx context.Context, opts ...grpc.CallOption) (ControlService_UploadArtifactClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ControlService_ServiceDesc.Streams[1], ControlService_UploadArtifact_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &controlServiceUploadArtifactClient{stream}
+	return x, nil
+}
+
+type ControlService_UploadArtifactClient interface {
+	Send(*UploadArtifactRequest) error
+	CloseAndRecv() (*UploadArtifactResponse, error)
+	grpc.ClientStream
+}
+
+type controlServiceUploadArtifactClient struct {
+	grpc.ClientStream
+}
+
+func (x *controlServiceUploadArtifactClient) Send(m *UploadArtifactRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *controlServiceUploadArtifactClient) CloseAndRecv() (*UploadArtifactResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadArtifactResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *controlServiceClient) ReplaceVMPlugin(ctx context.Context, in *ReplaceVMPluginRequest, opts ...grpc.CallOption) (*ReplaceVMPluginResponse, error) {
+	out := new(ReplaceVMPluginResponse)
+	err := c.cc.Invoke(ctx, ControlService_ReplaceVMPlugin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) UpdateChainConfig(ctx context.Context, in *UpdateChainConfigRequest, opts ...grpc.CallOption) (*UpdateChainConfigResponse, error) {
+	out := new(UpdateChainConfigResponse)
+	err := c.cc.Invoke(ctx, ControlService_UpdateChainConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) UpdateSubnetConfig(ctx context.Context, in *UpdateSubnetConfigRequest, opts ...grpc.CallOption) (*UpdateSubnetConfigResponse, error) {
+	out := new(UpdateSubnetConfigResponse)
+	err := c.cc.Invoke(ctx, ControlService_UpdateSubnetConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) SetAddressState(ctx context.Context, in *SetAddressStateRequest, opts ...grpc.CallOption) (*SetAddressStateResponse, error) {
+	out := new(SetAddressStateResponse)
+	err := c.cc.Invoke(ctx, ControlService_SetAddressState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) GetAddressState(ctx context.Context, in *GetAddressStateRequest, opts ...grpc.CallOption) (*GetAddressStateResponse, error) {
+	out := new(GetAddressStateResponse)
+	err := c.cc.Invoke(ctx, ControlService_GetAddressState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) GetDepositOffers(ctx context.Context, in *GetDepositOffersRequest, opts ...grpc.CallOption) (*GetDepositOffersResponse, error) {
+	out := new(GetDepositOffersResponse)
+	err := c.cc.Invoke(ctx, ControlService_GetDepositOffers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error) {
+	out := new(DepositResponse)
+	err := c.cc.Invoke(ctx, ControlService_Deposit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) ClaimRewards(ctx context.Context, in *ClaimRewardsRequest, opts ...grpc.CallOption) (*ClaimRewardsResponse, error) {
+	out := new(ClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, ControlService_ClaimRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) UnlockDeposit(ctx context.Context, in *UnlockDepositRequest, opts ...grpc.CallOption) (*UnlockDepositResponse, error) {
+	out := new(UnlockDepositResponse)
+	err := c.cc.Invoke(ctx, ControlService_UnlockDeposit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) CreateMultisigAlias(ctx context.Context, in *CreateMultisigAliasRequest, opts ...grpc.CallOption) (*CreateMultisigAliasResponse, error) {
+	out := new(CreateMultisigAliasResponse)
+	err := c.cc.Invoke(ctx, ControlService_CreateMultisigAlias_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) GrantCChainRole(ctx context.Context, in *CChainRoleRequest, opts ...grpc.CallOption) (*CChainRoleResponse, error) {
+	out := new(CChainRoleResponse)
+	err := c.cc.Invoke(ctx, ControlService_GrantCChainRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) RevokeCChainRole(ctx context.Context, in *CChainRoleRequest, opts ...grpc.CallOption) (*CChainRoleResponse, error) {
+	out := new(CChainRoleResponse)
+	err := c.cc.Invoke(ctx, ControlService_RevokeCChainRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) GetCChainRoles(ctx context.Context, in *GetCChainRolesRequest, opts ...grpc.CallOption) (*GetCChainRolesResponse, error) {
+	out := new(GetCChainRolesResponse)
+	err := c.cc.Invoke(ctx, ControlService_GetCChainRoles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) AddDelegator(ctx context.Context, in *AddDelegatorRequest, opts ...grpc.CallOption) (*AddDelegatorResponse, error) {
+	out := new(AddDelegatorResponse)
+	err := c.cc.Invoke(ctx, ControlService_AddDelegator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ControlServiceServer is the server API for ControlService service.
 // All implementations must embed UnimplementedControlServiceServer
 // for forward compatibility
 type ControlServiceServer interface {
 	RPCVersion(context.Context, *RPCVersionRequest) (*RPCVersionResponse, error)
 	Start(context.Context, *StartRequest) (*StartResponse, error)
+	JoinExternalNetwork(context.Context, *JoinExternalNetworkRequest) (*JoinExternalNetworkResponse, error)
 	CreateBlockchains(context.Context, *CreateBlockchainsRequest) (*CreateBlockchainsResponse, error)
 	CreateSubnets(context.Context, *CreateSubnetsRequest) (*CreateSubnetsResponse, error)
 	Health(context.Context, *HealthRequest) (*HealthResponse, error)
@@ -404,6 +650,25 @@ type ControlServiceServer interface {
 	LoadSnapshot(context.Context, *LoadSnapshotRequest) (*LoadSnapshotResponse, error)
 	RemoveSnapshot(context.Context, *RemoveSnapshotRequest) (*RemoveSnapshotResponse, error)
 	GetSnapshotNames(context.Context, *GetSnapshotNamesRequest) (*GetSnapshotNamesResponse, error)
+	MigrateSnapshot(context.Context, *MigrateSnapshotRequest) (*MigrateSnapshotResponse, error)
+	RegisterBinary(context.Context, *RegisterBinaryRequest) (*RegisterBinaryResponse, error)
+	ListBinaries(context.Context, *ListBinariesRequest) (*ListBinariesResponse, error)
+	RemoveBinary(context.Context, *RemoveBinaryRequest) (*RemoveBinaryResponse, error)
+	UploadArtifact(ControlService_UploadArtifactServer) error
+	ReplaceVMPlugin(context.Context, *ReplaceVMPluginRequest) (*ReplaceVMPluginResponse, error)
+	UpdateChainConfig(context.Context, *UpdateChainConfigRequest) (*UpdateChainConfigResponse, error)
+	UpdateSubnetConfig(context.Context, *UpdateSubnetConfigRequest) (*UpdateSubnetConfigResponse, error)
+	SetAddressState(context.Context, *SetAddressStateRequest) (*SetAddressStateResponse, error)
+	GetAddressState(context.Context, *GetAddressStateRequest) (*GetAddressStateResponse, error)
+	GetDepositOffers(context.Context, *GetDepositOffersRequest) (*GetDepositOffersResponse, error)
+	Deposit(context.Context, *DepositRequest) (*DepositResponse, error)
+	ClaimRewards(context.Context, *ClaimRewardsRequest) (*ClaimRewardsResponse, error)
+	UnlockDeposit(context.Context, *UnlockDepositRequest) (*UnlockDepositResponse, error)
+	CreateMultisigAlias(context.Context, *CreateMultisigAliasRequest) (*CreateMultisigAliasResponse, error)
+	GrantCChainRole(context.Context, *CChainRoleRequest) (*CChainRoleResponse, error)
+	RevokeCChainRole(context.Context, *CChainRoleRequest) (*CChainRoleResponse, error)
+	GetCChainRoles(context.Context, *GetCChainRolesRequest) (*GetCChainRolesResponse, error)
+	AddDelegator(context.Context, *AddDelegatorRequest) (*AddDelegatorResponse, error)
 	mustEmbedUnimplementedControlServiceServer()
 }
 
@@ -417,6 +682,9 @@ func (UnimplementedControlServiceServer) RPCVersion(context.Context, *RPCVersion
 func (UnimplementedControlServiceServer) Start(context.Context, *StartRequest) (*StartResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
 }
+func (UnimplementedControlServiceServer) JoinExternalNetwork(context.Context, *JoinExternalNetworkRequest) (*JoinExternalNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinExternalNetwork not implemented")
+}
 func (UnimplementedControlServiceServer) CreateBlockchains(context.Context, *CreateBlockchainsRequest) (*CreateBlockchainsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBlockchains not implemented")
 }
@@ -474,6 +742,63 @@ func (UnimplementedControlServiceServer) RemoveSnapshot(context.Context, *Remove
 func (UnimplementedControlServiceServer) GetSnapshotNames(context.Context, *GetSnapshotNamesRequest) (*GetSnapshotNamesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSnapshotNames not implemented")
 }
+func (UnimplementedControlServiceServer) MigrateSnapshot(context.Context, *MigrateSnapshotRequest) (*MigrateSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateSnapshot not implemented")
+}
+func (UnimplementedControlServiceServer) RegisterBinary(context.Context, *RegisterBinaryRequest) (*RegisterBinaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterBinary not implemented")
+}
+func (UnimplementedControlServiceServer) ListBinaries(context.Context, *ListBinariesRequest) (*ListBinariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBinaries not implemented")
+}
+func (UnimplementedControlServiceServer) RemoveBinary(context.Context, *RemoveBinaryRequest) (*RemoveBinaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBinary not implemented")
+}
+func (UnimplementedControlServiceServer) UploadArtifact(ControlService_UploadArtifactServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadArtifact not implemented")
+}
+func (UnimplementedControlServiceServer) ReplaceVMPlugin(context.Context, *ReplaceVMPluginRequest) (*ReplaceVMPluginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceVMPlugin not implemented")
+}
+func (UnimplementedControlServiceServer) UpdateChainConfig(context.Context, *UpdateChainConfigRequest) (*UpdateChainConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChainConfig not implemented")
+}
+func (UnimplementedControlServiceServer) UpdateSubnetConfig(context.Context, *UpdateSubnetConfigRequest) (*UpdateSubnetConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubnetConfig not implemented")
+}
+func (UnimplementedControlServiceServer) SetAddressState(context.Context, *SetAddressStateRequest) (*SetAddressStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAddressState not implemented")
+}
+func (UnimplementedControlServiceServer) GetAddressState(context.Context, *GetAddressStateRequest) (*GetAddressStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddressState not implemented")
+}
+func (UnimplementedControlServiceServer) GetDepositOffers(context.Context, *GetDepositOffersRequest) (*GetDepositOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepositOffers not implemented")
+}
+func (UnimplementedControlServiceServer) Deposit(context.Context, *DepositRequest) (*DepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (UnimplementedControlServiceServer) ClaimRewards(context.Context, *ClaimRewardsRequest) (*ClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+func (UnimplementedControlServiceServer) UnlockDeposit(context.Context, *UnlockDepositRequest) (*UnlockDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockDeposit not implemented")
+}
+func (UnimplementedControlServiceServer) CreateMultisigAlias(context.Context, *CreateMultisigAliasRequest) (*CreateMultisigAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMultisigAlias not implemented")
+}
+func (UnimplementedControlServiceServer) GrantCChainRole(context.Context, *CChainRoleRequest) (*CChainRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantCChainRole not implemented")
+}
+func (UnimplementedControlServiceServer) RevokeCChainRole(context.Context, *CChainRoleRequest) (*CChainRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCChainRole not implemented")
+}
+func (UnimplementedControlServiceServer) GetCChainRoles(context.Context, *GetCChainRolesRequest) (*GetCChainRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCChainRoles not implemented")
+}
+func (UnimplementedControlServiceServer) AddDelegator(context.Context, *AddDelegatorRequest) (*AddDelegatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDelegator not implemented")
+}
 func (UnimplementedControlServiceServer) mustEmbedUnimplementedControlServiceServer() {}
 
 // UnsafeControlServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -523,6 +848,24 @@ func _ControlService_Start_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControlService_JoinExternalNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinExternalNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).JoinExternalNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_JoinExternalNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).JoinExternalNetwork(ctx, req.(*JoinExternalNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ControlService_CreateBlockchains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateBlockchainsRequest)
 	if err := dec(in); err != nil {
@@ -868,6 +1211,356 @@ func _ControlService_GetSnapshotNames_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControlService_MigrateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).MigrateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_MigrateSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).MigrateSnapshot(ctx, req.(*MigrateSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_RegisterBinary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterBinaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).RegisterBinary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_RegisterBinary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).RegisterBinary(ctx, req.(*RegisterBinaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_ListBinaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBinariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).ListBinaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_ListBinaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).ListBinaries(ctx, req.(*ListBinariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_RemoveBinary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBinaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).RemoveBinary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_RemoveBinary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).RemoveBinary(ctx, req.(*RemoveBinaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_UploadArtifact_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ControlServiceServer).UploadArtifact(&controlServiceUploadArtifactServer{stream})
+}
+
+type ControlService_UploadArtifactServer interface {
+	SendAndClose(*UploadArtifactResponse) error
+	Recv() (*UploadArtifactRequest, error)
+	grpc.ServerStream
+}
+
+type controlServiceUploadArtifactServer struct {
+	grpc.ServerStream
+}
+
+func (x *controlServiceUploadArtifactServer) SendAndClose(m *UploadArtifactResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *controlServiceUploadArtifactServer) Recv() (*UploadArtifactRequest, error) {
+	m := new(UploadArtifactRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ControlService_ReplaceVMPlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceVMPluginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).ReplaceVMPlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_ReplaceVMPlugin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).ReplaceVMPlugin(ctx, req.(*ReplaceVMPluginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_UpdateChainConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChainConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).UpdateChainConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_UpdateChainConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).UpdateChainConfig(ctx, req.(*UpdateChainConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_UpdateSubnetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubnetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).UpdateSubnetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_UpdateSubnetConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).UpdateSubnetConfig(ctx, req.(*UpdateSubnetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_SetAddressState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAddressStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).SetAddressState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_SetAddressState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).SetAddressState(ctx, req.(*SetAddressStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_GetAddressState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).GetAddressState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_GetAddressState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).GetAddressState(ctx, req.(*GetAddressStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_GetDepositOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepositOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).GetDepositOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_GetDepositOffers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).GetDepositOffers(ctx, req.(*GetDepositOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_Deposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).Deposit(ctx, req.(*DepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_ClaimRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).ClaimRewards(ctx, req.(*ClaimRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_UnlockDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).UnlockDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_UnlockDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).UnlockDeposit(ctx, req.(*UnlockDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_CreateMultisigAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMultisigAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).CreateMultisigAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_CreateMultisigAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).CreateMultisigAlias(ctx, req.(*CreateMultisigAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_GrantCChainRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CChainRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).GrantCChainRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_GrantCChainRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).GrantCChainRole(ctx, req.(*CChainRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_RevokeCChainRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CChainRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).RevokeCChainRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_RevokeCChainRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).RevokeCChainRole(ctx, req.(*CChainRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_GetCChainRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCChainRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).GetCChainRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_GetCChainRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).GetCChainRoles(ctx, req.(*GetCChainRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_AddDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDelegatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).AddDelegator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_AddDelegator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).AddDelegator(ctx, req.(*AddDelegatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ControlService_ServiceDesc is the grpc.ServiceDesc for ControlService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -883,6 +1576,10 @@ var ControlService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Start",
 			Handler:    _ControlService_Start_Handler,
 		},
+		{
+			MethodName: "JoinExternalNetwork",
+			Handler:    _ControlService_JoinExternalNetwork_Handler,
+		},
 		{
 			MethodName: "CreateBlockchains",
 			Handler:    _ControlService_CreateBlockchains_Handler,
@@ -955,6 +1652,78 @@ var ControlService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSnapshotNames",
 			Handler:    _ControlService_GetSnapshotNames_Handler,
 		},
+		{
+			MethodName: "MigrateSnapshot",
+			Handler:    _ControlService_MigrateSnapshot_Handler,
+		},
+		{
+			MethodName: "RegisterBinary",
+			Handler:    _ControlService_RegisterBinary_Handler,
+		},
+		{
+			MethodName: "ListBinaries",
+			Handler:    _ControlService_ListBinaries_Handler,
+		},
+		{
+			MethodName: "RemoveBinary",
+			Handler:    _ControlService_RemoveBinary_Handler,
+		},
+		{
+			MethodName: "ReplaceVMPlugin",
+			Handler:    _ControlService_ReplaceVMPlugin_Handler,
+		},
+		{
+			MethodName: "UpdateChainConfig",
+			Handler:    _ControlService_UpdateChainConfig_Handler,
+		},
+		{
+			MethodName: "UpdateSubnetConfig",
+			Handler:    _ControlService_UpdateSubnetConfig_Handler,
+		},
+		{
+			MethodName: "SetAddressState",
+			Handler:    _ControlService_SetAddressState_Handler,
+		},
+		{
+			MethodName: "GetAddressState",
+			Handler:    _ControlService_GetAddressState_Handler,
+		},
+		{
+			MethodName: "GetDepositOffers",
+			Handler:    _ControlService_GetDepositOffers_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _ControlService_Deposit_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _ControlService_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "UnlockDeposit",
+			Handler:    _ControlService_UnlockDeposit_Handler,
+		},
+		{
+			MethodName: "CreateMultisigAlias",
+			Handler:    _ControlService_CreateMultisigAlias_Handler,
+		},
+		{
+			MethodName: "GrantCChainRole",
+			Handler:    _ControlService_GrantCChainRole_Handler,
+		},
+		{
+			MethodName: "RevokeCChainRole",
+			Handler:    _ControlService_RevokeCChainRole_Handler,
+		},
+		{
+			MethodName: "GetCChainRoles",
+			Handler:    _ControlService_GetCChainRoles_Handler,
+		},
+		{
+			MethodName: "AddDelegator",
+			Handler:    _ControlService_AddDelegator_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -962,6 +1731,11 @@ var ControlService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ControlService_StreamStatus_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "UploadArtifact",
+			Handler:       _ControlService_UploadArtifact_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "rpcpb/rpc.proto",
 }
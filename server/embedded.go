@@ -0,0 +1,43 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+
+	"github.com/ava-labs/avalanche-network-runner/client"
+	"github.com/ava-labs/avalanche-network-runner/client/inproc"
+	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+// NewEmbedded returns a client that runs the server logic in-process,
+// without listening on any port. Requests are dispatched directly to the
+// server handlers, with the same message copying and error semantics as
+// a gRPC round trip, so code written against [client.Client] behaves the
+// same with a remote or an embedded runner.
+// [cfg.Port], [cfg.GwPort], [cfg.GwDisabled] and [cfg.DialTimeout] are ignored.
+// Closing the client stops the network, if any.
+func NewEmbedded(cfg Config, log logging.Logger) client.Client {
+	s := newServer(cfg, log)
+	s.rootCtx, s.rootCancel = context.WithCancel(context.Background())
+
+	conn := inproc.NewConn(
+		s,
+		func() error {
+			s.rootCancel()
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if s.network != nil {
+				s.stopAndRemoveNetwork(nil)
+				s.log.Warn("network stopped")
+			}
+			close(s.closed)
+			return nil
+		},
+		&rpcpb.PingService_ServiceDesc,
+		&rpcpb.ControlService_ServiceDesc,
+	)
+	return client.NewWithConn(client.Config{Endpoint: "embedded"}, conn, log)
+}
@@ -0,0 +1,268 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/client"
+	"github.com/ava-labs/avalanche-network-runner/local/fakenode"
+	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanche-network-runner/utils/artifacts"
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEmbedded(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	cli := NewEmbedded(Config{SnapshotsDir: t.TempDir()}, logging.NoLog{})
+	ctx := context.Background()
+
+	pingResp, err := cli.Ping(ctx)
+	require.NoError(err)
+	require.Equal(int32(os.Getpid()), pingResp.Pid)
+
+	versionResp, err := cli.RPCVersion(ctx)
+	require.NoError(err)
+	require.Equal(RPCVersion, versionResp.Version)
+
+	// domain errors are reported as after a gRPC round trip
+	_, err = cli.Status(ctx)
+	require.True(IsServerError(err, ErrNotBootstrapped))
+	_, err = cli.Start(ctx, "")
+	require.True(IsServerError(err, utils.ErrInvalidExecPath))
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	ch, err := cli.StreamStatus(streamCtx, time.Millisecond)
+	require.NoError(err)
+	<-ch
+	<-ch
+	cancel()
+	for range ch {
+	}
+
+	require.NoError(cli.Close())
+	_, err = cli.Ping(ctx)
+	require.Equal(codes.Canceled, status.Code(err))
+}
+
+func TestEmbeddedFakeNodes(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	pc := fakenode.NewProcessCreator()
+	cli := NewEmbedded(Config{
+		SnapshotsDir:       t.TempDir(),
+		LogLevel:           logging.Off,
+		NodeProcessCreator: pc,
+	}, logging.NoLog{})
+	defer cli.Close()
+	ctx := context.Background()
+
+	// the fake backend ignores the binary, but it has to exist
+	execPath, err := os.Executable()
+	require.NoError(err)
+	startResp, err := cli.Start(ctx, execPath,
+		client.WithNumNodes(3),
+		client.WithRootDataDir(t.TempDir()),
+		client.WithDynamicPorts(true),
+		client.WithUpgradeSchedule(
+			map[string]string{"sunrisePhase0BlockTimestamp": "1h"},
+		),
+	)
+	require.NoError(err)
+	require.Equal([]string{"node1", "node2", "node3"}, startResp.ClusterInfo.NodeNames)
+
+	_, err = cli.WaitForHealthy(ctx)
+	require.NoError(err)
+	statusResp, err := cli.Status(ctx)
+	require.NoError(err)
+	upgrades := map[string]*rpcpb.UpgradeInfo{}
+	for _, upgrade := range statusResp.ClusterInfo.Upgrades {
+		upgrades[upgrade.Chain+"/"+upgrade.Name] = upgrade
+	}
+	require.False(upgrades["C/sunrisePhase0BlockTimestamp"].Active)
+	require.True(upgrades["C/banffBlockTimestamp"].Active)
+
+	_, err = cli.RestartNode(ctx, "node2")
+	require.NoError(err)
+	n2, err := pc.Node("node2")
+	require.NoError(err)
+	require.Equal(2, n2.Starts())
+
+	saveResp, err := cli.SaveSnapshot(ctx, "fake")
+	require.NoError(err)
+	require.Len(saveResp.DbCopies, 3)
+	loadResp, err := cli.LoadSnapshot(ctx, "fake", client.WithRootDataDir(t.TempDir()))
+	require.NoError(err)
+	require.Len(loadResp.DbCopies, 3)
+	require.NotEmpty(loadResp.DbCopies["node2"].Strategy)
+	healthResp, err := cli.Health(ctx)
+	require.NoError(err)
+	require.Len(healthResp.ClusterInfo.NodeInfos, 3)
+	// the schedule is kept in the snapshot
+	waitResp, err := cli.WaitForHealthy(ctx)
+	require.NoError(err)
+	require.Equal(statusResp.ClusterInfo.Upgrades, waitResp.ClusterInfo.Upgrades)
+	n2, err = pc.Node("node2")
+	require.NoError(err)
+	require.Equal(3, n2.Starts())
+}
+
+func TestEmbeddedUploadArtifact(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	pc := fakenode.NewProcessCreator()
+	artifactsDir := t.TempDir()
+	cli := NewEmbedded(Config{
+		SnapshotsDir:       t.TempDir(),
+		ArtifactsDir:       artifactsDir,
+		LogLevel:           logging.Off,
+		NodeProcessCreator: pc,
+	}, logging.NoLog{})
+	defer cli.Close()
+	ctx := context.Background()
+
+	// sent in several chunks
+	binary := bytes.Repeat([]byte("camino-node"), 300_000)
+	binaryPath := filepath.Join(t.TempDir(), "camino-node")
+	require.NoError(os.WriteFile(binaryPath, binary, 0o755))
+	binaryResp, err := cli.UploadArtifact(ctx, binaryPath)
+	require.NoError(err)
+	require.Equal(uint64(len(binary)), binaryResp.Size)
+	require.Equal(artifacts.Ref(binaryResp.ArtifactId), binaryResp.Ref)
+
+	pluginDir := filepath.Join(t.TempDir(), "plugins")
+	require.NoError(os.MkdirAll(pluginDir, 0o755))
+	require.NoError(os.WriteFile(filepath.Join(pluginDir, "vm"), []byte("vm"), 0o755))
+	pluginDirResp, err := cli.UploadArtifact(ctx, pluginDir)
+	require.NoError(err)
+
+	startResp, err := cli.Start(ctx, binaryResp.Ref,
+		client.WithPluginDir(pluginDirResp.Ref),
+		client.WithNumNodes(3),
+		client.WithRootDataDir(t.TempDir()),
+		client.WithDynamicPorts(true),
+	)
+	require.NoError(err)
+	nodeInfo := startResp.ClusterInfo.NodeInfos["node1"]
+	stored, err := os.ReadFile(nodeInfo.ExecPath)
+	require.NoError(err)
+	require.Equal(binary, stored)
+	vm, err := os.ReadFile(filepath.Join(nodeInfo.PluginDir, "vm"))
+	require.NoError(err)
+	require.Equal([]byte("vm"), vm)
+
+	_, err = cli.AddNode(ctx, "node4", artifacts.Ref(strings.Repeat("0", 64)))
+	require.True(IsServerError(err, fmt.Errorf("%w: %s", artifacts.ErrArtifactNotFound, strings.Repeat("0", 64))))
+}
+
+func TestEmbeddedJoinExternalNetwork(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	// the external network
+	externalPC := fakenode.NewProcessCreator()
+	externalCli := NewEmbedded(Config{
+		SnapshotsDir:       t.TempDir(),
+		LogLevel:           logging.Off,
+		NodeProcessCreator: externalPC,
+	}, logging.NoLog{})
+	defer externalCli.Close()
+	ctx := context.Background()
+
+	execPath, err := os.Executable()
+	require.NoError(err)
+	startResp, err := externalCli.Start(ctx, execPath,
+		client.WithNumNodes(1),
+		client.WithRootDataDir(t.TempDir()),
+		client.WithDynamicPorts(true),
+	)
+	require.NoError(err)
+	beacon, err := externalPC.Node("node1")
+	require.NoError(err)
+	genesisPath, ok := beacon.Flag(config.GenesisConfigFileKey)
+	require.True(ok)
+	genesis, err := os.ReadFile(genesisPath)
+	require.NoError(err)
+	networkID, err := utils.NetworkIDFromGenesis(genesis)
+	require.NoError(err)
+	peer := &rpcpb.BootstrapPeer{
+		Id: startResp.ClusterInfo.NodeInfos["node1"].Id,
+		Ip: fmt.Sprintf("127.0.0.1:%d", beacon.P2PPort()),
+	}
+
+	pc := fakenode.NewProcessCreator()
+	cli := NewEmbedded(Config{
+		SnapshotsDir:       t.TempDir(),
+		LogLevel:           logging.Off,
+		NodeProcessCreator: pc,
+	}, logging.NoLog{})
+	defer cli.Close()
+
+	_, err = cli.JoinExternalNetwork(ctx, execPath, string(genesis), networkID+1, []*rpcpb.BootstrapPeer{peer})
+	require.ErrorContains(err, "doesn't match the network ID")
+	_, err = cli.JoinExternalNetwork(ctx, execPath, string(genesis), networkID, nil)
+	require.True(IsServerError(err, ErrNoBootstrapPeers))
+
+	joinResp, err := cli.JoinExternalNetwork(ctx, execPath, string(genesis), networkID, []*rpcpb.BootstrapPeer{peer},
+		client.WithNumNodes(2),
+		client.WithRootDataDir(t.TempDir()),
+	)
+	require.NoError(err)
+	require.Equal([]string{"node1", "node2"}, joinResp.ClusterInfo.NodeNames)
+	require.Len(joinResp.ClusterInfo.ExternalPeers, 1)
+	require.Equal(peer.Id, joinResp.ClusterInfo.ExternalPeers[0].Id)
+	for _, nodeInfo := range joinResp.ClusterInfo.NodeInfos {
+		require.NotEqual(peer.Id, nodeInfo.Id)
+		require.Len(nodeInfo.BootstrapPeers, 1)
+		require.Equal(peer.Ip, nodeInfo.BootstrapPeers[0].Ip)
+	}
+	_, err = cli.WaitForHealthy(ctx)
+	require.NoError(err)
+	joined, err := pc.Node("node2")
+	require.NoError(err)
+	bootstrapIDs, _ := joined.Flag(config.BootstrapIDsKey)
+	require.Equal(peer.Id, bootstrapIDs)
+	networkName, _ := joined.Flag(config.NetworkNameKey)
+	require.Equal(fmt.Sprintf("%d", networkID), networkName)
+
+	_, err = cli.PauseNode(ctx, "node2")
+	require.NoError(err)
+	_, err = cli.ResumeNode(ctx, "node2")
+	require.NoError(err)
+	joined, err = pc.Node("node2")
+	require.NoError(err)
+	bootstrapIDs, _ = joined.Flag(config.BootstrapIDsKey)
+	require.Equal(peer.Id, bootstrapIDs)
+
+	// the external peers are kept in the snapshot
+	_, err = cli.SaveSnapshot(ctx, "joined")
+	require.NoError(err)
+	_, err = cli.LoadSnapshot(ctx, "joined", client.WithRootDataDir(t.TempDir()))
+	require.NoError(err)
+	waitResp, err := cli.WaitForHealthy(ctx)
+	require.NoError(err)
+	require.Len(waitResp.ClusterInfo.NodeInfos, 2)
+	require.Len(waitResp.ClusterInfo.ExternalPeers, 1)
+	require.Equal(peer.Id, waitResp.ClusterInfo.ExternalPeers[0].Id)
+	joined, err = pc.Node("node1")
+	require.NoError(err)
+	bootstrapIPs, _ := joined.Flag(config.BootstrapIPsKey)
+	require.Equal(peer.Ip, bootstrapIPs)
+}
@@ -12,13 +12,19 @@ import (
 
 	"github.com/ava-labs/avalanche-network-runner/local"
 	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/network/node"
 	"github.com/ava-labs/avalanche-network-runner/rpcpb"
 	"github.com/ava-labs/avalanche-network-runner/utils/constants"
 	"github.com/ava-labs/avalanche-network-runner/ux"
 	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/genesis"
 	"github.com/ava-labs/avalanchego/ids"
 	avago_constants "github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	platformapi "github.com/ava-labs/avalanchego/vms/platformvm/api"
+	"go.uber.org/zap"
 	"golang.org/x/exp/maps"
 )
 
@@ -47,6 +53,14 @@ type localNetwork struct {
 	subnets []string
 	// map from subnet ID to list of participating node ids
 	subnetParticipants map[string][]string
+	// map from node name to its current delegators
+	delegators map[string][]*rpcpb.DelegatorInfo
+
+	// C-chain phases and P-chain upgrades of the network
+	upgrades []network.Upgrade
+	// peers of the external network joined, nil if the network is owned by
+	// the runner
+	externalPeers []node.BootstrapPeer
 }
 
 type chainInfo struct {
@@ -80,6 +94,43 @@ type localNetworkOptions struct {
 	reassignPortsIfUsed bool
 
 	dynamicPorts bool
+
+	// range to allocate node ports from, zero for the default range
+	portRange network.PortRange
+
+	// run each node in its own network namespace
+	nodeNamespaces bool
+
+	// save snapshots to the deduplicated snapshot store
+	dedupSnapshots bool
+
+	// save the private keys given inline in snapshots
+	snapshotKeys bool
+
+	// activation times of network upgrades, offsets are from the network creation
+	upgradeSchedule network.UpgradeSchedule
+
+	// bond of the nodes added as primary network validators
+	validatorBond network.ValidatorBond
+
+	// deposit offers added to the camino genesis
+	depositOffers []genesis.UnparsedDepositOffer
+
+	// keys funding the P-chain txs, and file with further ones
+	fundingKeys    []string
+	fundingKeyFile string
+
+	// key holding the admin role of the P-chain and the C-chain, empty for
+	// the initial admins of the local genesis
+	adminKey string
+
+	// used to launch node processes, nil for camino-node binaries
+	nodeProcessCreator local.NodeProcessCreator
+
+	// genesis and peers of the external network joined by the nodes, instead
+	// of creating a network
+	externalGenesis string
+	externalPeers   []node.BootstrapPeer
 }
 
 func newLocalNetwork(opts localNetworkOptions) (*localNetwork, error) {
@@ -104,13 +155,22 @@ func newLocalNetwork(opts localNetworkOptions) (*localNetwork, error) {
 		stopCh:              make(chan struct{}),
 		nodeInfos:           make(map[string]*rpcpb.NodeInfo),
 		subnetParticipants:  make(map[string][]string),
+		delegators:          make(map[string][]*rpcpb.DelegatorInfo),
 	}, nil
 }
 
 // TODO document.
 // Assumes [lc.lock] is held.
 func (lc *localNetwork) createConfig() error {
-	cfg, err := local.NewDefaultConfigNNodes(lc.options.execPath, lc.options.numNodes)
+	var (
+		cfg network.Config
+		err error
+	)
+	if len(lc.options.externalPeers) > 0 {
+		cfg, err = local.NewExternalNetworkConfig(lc.options.execPath, lc.options.externalGenesis, lc.options.numNodes, lc.options.externalPeers)
+	} else {
+		cfg, err = local.NewDefaultConfigNNodes(lc.options.execPath, lc.options.numNodes)
+	}
 	if err != nil {
 		return err
 	}
@@ -132,6 +192,22 @@ func (lc *localNetwork) createConfig() error {
 		cfg.Flags[config.PluginDirKey] = lc.pluginDir
 	}
 
+	cfg.PortRange = lc.options.portRange
+	cfg.NodeNamespaces = lc.options.nodeNamespaces
+	cfg.DedupSnapshots = lc.options.dedupSnapshots
+	cfg.SnapshotKeys = lc.options.snapshotKeys
+	cfg.ValidatorBond = lc.options.validatorBond
+	cfg.FundingKeys = lc.options.fundingKeys
+	cfg.FundingKeyFile = lc.options.fundingKeyFile
+	cfg.AdminKey = lc.options.adminKey
+	// the genesis of an external network can't be changed
+	if len(cfg.ExternalPeers) == 0 {
+		if err := cfg.AddDepositOffers(lc.options.depositOffers); err != nil {
+			return err
+		}
+		cfg.UpgradeSchedule = lc.options.upgradeSchedule
+	}
+
 	for i := range cfg.NodeConfigs {
 		// NOTE: Naming convention for node names is currently `node` + number, i.e. `node1,node2,node3,...node101`
 		nodeName := fmt.Sprintf("node%d", i+1)
@@ -193,7 +269,14 @@ func (lc *localNetwork) Start() error {
 	}
 
 	ux.Print(lc.log, logging.Blue.Wrap(logging.Bold.Wrap("create and run local network")))
-	nw, err := local.NewNetwork(lc.log, lc.cfg, lc.options.rootDataDir, lc.options.snapshotsDir, lc.options.reassignPortsIfUsed)
+	nw, err := local.NewNetworkWithNodeProcessCreator(
+		lc.log,
+		lc.cfg,
+		lc.options.rootDataDir,
+		lc.options.snapshotsDir,
+		lc.options.reassignPortsIfUsed,
+		lc.options.nodeProcessCreator,
+	)
 	if err != nil {
 		return err
 	}
@@ -204,7 +287,13 @@ func (lc *localNetwork) Start() error {
 		return err
 	}
 
-	return nil
+	// upgrades are fixed at network creation
+	lc.upgrades, err = lc.nw.GetUpgrades()
+	if err != nil {
+		return err
+	}
+	lc.externalPeers, err = lc.nw.GetExternalPeers()
+	return err
 }
 
 // Creates the blockchains specified in [chainSpecs].
@@ -290,6 +379,72 @@ func (lc *localNetwork) CreateSubnets(ctx context.Context, subnetSpecs []network
 	return subnetIDs, nil
 }
 
+// Replaces the plugin of VM [vmID] by the plugin binary at [pluginPath].
+// Assumes [lc.lock] isn't held.
+func (lc *localNetwork) ReplaceVMPlugin(ctx context.Context, vmID ids.ID, pluginPath string) ([]string, error) {
+	return lc.restartNodes(ctx, "finished replacing vm plugin", func(ctx context.Context) ([]string, error) {
+		return lc.nw.ReplaceVMPlugin(ctx, vmID, pluginPath)
+	})
+}
+
+// Updates the config of blockchain [chainID].
+// Assumes [lc.lock] isn't held.
+func (lc *localNetwork) UpdateChainConfig(
+	ctx context.Context,
+	chainID ids.ID,
+	chainConfig []byte,
+	perNodeChainConfig map[string][]byte,
+) ([]string, error) {
+	return lc.restartNodes(ctx, "finished updating chain config", func(ctx context.Context) ([]string, error) {
+		return lc.nw.UpdateChainConfig(ctx, chainID, chainConfig, perNodeChainConfig)
+	})
+}
+
+// Updates the config of subnet [subnetID].
+// Assumes [lc.lock] isn't held.
+func (lc *localNetwork) UpdateSubnetConfig(ctx context.Context, subnetID ids.ID, subnetConfig []byte) ([]string, error) {
+	return lc.restartNodes(ctx, "finished updating subnet config", func(ctx context.Context) ([]string, error) {
+		return lc.nw.UpdateSubnetConfig(ctx, subnetID, subnetConfig)
+	})
+}
+
+// Runs [op], which restarts nodes of the network and returns their names,
+// and then waits for the network to be healthy again.
+// Assumes [lc.lock] isn't held.
+func (lc *localNetwork) restartNodes(
+	ctx context.Context,
+	doneMsg string,
+	op func(context.Context) ([]string, error),
+) ([]string, error) {
+	lc.lock.Lock()
+	defer lc.lock.Unlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func(ctx context.Context) {
+		select {
+		case <-lc.stopCh:
+			// The network is stopped; return from method calls below.
+			cancel()
+		case <-ctx.Done():
+			// This method is done. Don't leak [ctx].
+		}
+	}(ctx)
+
+	restarted, err := op(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := lc.awaitHealthyAndUpdateNetworkInfo(ctx); err != nil {
+		return nil, err
+	}
+
+	ux.Print(lc.log, logging.Green.Wrap(logging.Bold.Wrap(doneMsg)))
+	return restarted, nil
+}
+
 // Loads a snapshot and sets [l.nw] to the network created from the snapshot.
 // Assumes [lc.lock] isn't held.
 func (lc *localNetwork) LoadSnapshot(snapshotName string) error {
@@ -305,7 +460,7 @@ func (lc *localNetwork) LoadSnapshot(snapshotName string) error {
 		}
 	}
 
-	nw, err := local.NewNetworkFromSnapshot(
+	nw, err := local.NewNetworkFromSnapshotWithNodeProcessCreator(
 		lc.log,
 		snapshotName,
 		lc.options.rootDataDir,
@@ -317,6 +472,7 @@ func (lc *localNetwork) LoadSnapshot(snapshotName string) error {
 		lc.options.subnetConfigs,
 		globalNodeConfig,
 		lc.options.reassignPortsIfUsed,
+		lc.options.nodeProcessCreator,
 	)
 	if err != nil {
 		return err
@@ -327,7 +483,12 @@ func (lc *localNetwork) LoadSnapshot(snapshotName string) error {
 		return err
 	}
 
-	return nil
+	lc.upgrades, err = lc.nw.GetUpgrades()
+	if err != nil {
+		return err
+	}
+	lc.externalPeers, err = lc.nw.GetExternalPeers()
+	return err
 }
 
 // Populates [lc.customChainIDToInfo] for all chains other than those on
@@ -410,7 +571,8 @@ func (lc *localNetwork) updateSubnetInfo(ctx context.Context) error {
 				}
 			}
 		}
-		if len(nodeNames) != len(vs) {
+		// validators of an external network may be outside of it
+		if len(nodeNames) != len(vs) && len(lc.externalPeers) == 0 {
 			return fmt.Errorf("not all subnet validators are in network for subnet %s", chainInfo.subnetID.String())
 		}
 
@@ -453,6 +615,8 @@ func (lc *localNetwork) awaitHealthyAndUpdateNetworkInfo(ctx context.Context) er
 		return err
 	}
 
+	lc.updateDelegatorInfo(ctx)
+
 	nodeNames := maps.Keys(lc.nodeInfos)
 	sort.Strings(nodeNames)
 	for _, nodeName := range nodeNames {
@@ -466,6 +630,182 @@ func (lc *localNetwork) awaitHealthyAndUpdateNetworkInfo(ctx context.Context) er
 	return nil
 }
 
+// Assumes [lc.lock] isn't held.
+func (lc *localNetwork) UpdateDelegatorInfo(ctx context.Context) {
+	lc.lock.Lock()
+	defer lc.lock.Unlock()
+
+	lc.updateDelegatorInfo(ctx)
+}
+
+// Populates [lc.delegators], and the delegators of [lc.nodeInfos], with the
+// current and pending delegators to each node on the primary network and
+// [lc.subnets]. Delegators that can't be listed are logged and skipped.
+// Assumes [lc.lock] is held.
+func (lc *localNetwork) updateDelegatorInfo(ctx context.Context) {
+	var apiNode node.Node
+	for nodeName, nodeInfo := range lc.nodeInfos {
+		if nodeInfo.Paused {
+			continue
+		}
+		var err error
+		apiNode, err = lc.nw.GetNode(nodeName)
+		if err != nil {
+			lc.log.Warn("couldn't list delegators", zap.String("node-name", nodeName), zap.Error(err))
+			return
+		}
+		break
+	}
+	if apiNode == nil {
+		return
+	}
+	cctx, cancel := createDefaultCtx(ctx)
+	networkID, err := apiNode.GetAPIClient().InfoAPI().GetNetworkID(cctx)
+	cancel()
+	if err != nil {
+		lc.log.Warn("couldn't list delegators", zap.Error(err))
+		return
+	}
+	hrp := avago_constants.GetHRP(networkID)
+	subnetIDs := []ids.ID{avago_constants.PrimaryNetworkID}
+	for _, subnetIDStr := range lc.subnets {
+		subnetID, err := ids.FromString(subnetIDStr)
+		if err != nil {
+			lc.log.Warn("couldn't list delegators", zap.String("subnet-id", subnetIDStr), zap.Error(err))
+			continue
+		}
+		subnetIDs = append(subnetIDs, subnetID)
+	}
+
+	pChainClient := apiNode.GetAPIClient().PChainAPI()
+	lc.delegators = make(map[string][]*rpcpb.DelegatorInfo)
+	for _, subnetID := range subnetIDs {
+		// delegations start after their tx, so they are pending at first
+		pending, err := getPendingDelegatorInfos(ctx, pChainClient, subnetID)
+		if err != nil {
+			lc.log.Warn("couldn't list pending delegators", zap.Stringer("subnet-id", subnetID), zap.Error(err))
+		}
+		for nodeName, nodeInfo := range lc.nodeInfos {
+			nodeID, err := ids.NodeIDFromString(nodeInfo.Id)
+			if err != nil {
+				lc.log.Warn("couldn't list delegators", zap.String("node-name", nodeName), zap.Error(err))
+				continue
+			}
+			current, err := getCurrentDelegatorInfos(ctx, pChainClient, subnetID, nodeID, hrp)
+			if err != nil {
+				lc.log.Warn("couldn't list current delegators",
+					zap.String("node-name", nodeName),
+					zap.Stringer("subnet-id", subnetID),
+					zap.Error(err),
+				)
+			}
+			lc.delegators[nodeName] = append(lc.delegators[nodeName], current...)
+			lc.delegators[nodeName] = append(lc.delegators[nodeName], pending[nodeID]...)
+		}
+	}
+	for nodeName, nodeInfo := range lc.nodeInfos {
+		nodeInfo.Delegators = lc.delegators[nodeName]
+	}
+}
+
+// Returns the rpc infos of the current delegators to [nodeID] on [subnetID].
+func getCurrentDelegatorInfos(
+	ctx context.Context,
+	pChainClient platformvm.Client,
+	subnetID ids.ID,
+	nodeID ids.NodeID,
+	hrp string,
+) ([]*rpcpb.DelegatorInfo, error) {
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	// delegators are only listed when querying a single validator
+	vdrs, err := pChainClient.GetCurrentValidators(cctx, subnetID, []ids.NodeID{nodeID})
+	if err != nil {
+		return nil, err
+	}
+	delegatorInfos := []*rpcpb.DelegatorInfo{}
+	for _, vdr := range vdrs {
+		for _, delegator := range vdr.Delegators {
+			delegatorInfo, err := getDelegatorInfo(delegator, subnetID, hrp)
+			if err != nil {
+				return nil, err
+			}
+			delegatorInfos = append(delegatorInfos, delegatorInfo)
+		}
+	}
+	return delegatorInfos, nil
+}
+
+// Returns the rpc infos of the pending delegators on [subnetID], by node.
+// Pending delegators have no reward info.
+func getPendingDelegatorInfos(
+	ctx context.Context,
+	pChainClient platformvm.Client,
+	subnetID ids.ID,
+) (map[ids.NodeID][]*rpcpb.DelegatorInfo, error) {
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	// pending delegators to current validators are only listed when
+	// querying all nodes
+	_, delegators, err := pChainClient.GetPendingValidators(cctx, subnetID, nil)
+	if err != nil {
+		return nil, err
+	}
+	delegatorInfos := map[ids.NodeID][]*rpcpb.DelegatorInfo{}
+	for _, delegator := range delegators {
+		// the client doesn't decode pending stakers
+		b, err := json.Marshal(delegator)
+		if err != nil {
+			return nil, err
+		}
+		staker := platformapi.Staker{}
+		if err := json.Unmarshal(b, &staker); err != nil {
+			return nil, err
+		}
+		delegatorInfo := &rpcpb.DelegatorInfo{
+			TxId:      staker.TxID.String(),
+			Amount:    uint64(staker.Weight),
+			StartTime: uint64(staker.StartTime),
+			EndTime:   uint64(staker.EndTime),
+			Pending:   true,
+		}
+		if subnetID != avago_constants.PrimaryNetworkID {
+			delegatorInfo.SubnetId = subnetID.String()
+		}
+		delegatorInfos[staker.NodeID] = append(delegatorInfos[staker.NodeID], delegatorInfo)
+	}
+	return delegatorInfos, nil
+}
+
+// Returns the rpc info of [delegator] to a validator of [subnetID].
+func getDelegatorInfo(delegator platformvm.ClientDelegator, subnetID ids.ID, hrp string) (*rpcpb.DelegatorInfo, error) {
+	delegatorInfo := &rpcpb.DelegatorInfo{
+		TxId:      delegator.TxID.String(),
+		Amount:    delegator.Weight,
+		StartTime: delegator.StartTime,
+		EndTime:   delegator.EndTime,
+	}
+	if subnetID != avago_constants.PrimaryNetworkID {
+		delegatorInfo.SubnetId = subnetID.String()
+	}
+	if delegator.StakeAmount != nil {
+		delegatorInfo.Amount = *delegator.StakeAmount
+	}
+	if delegator.PotentialReward != nil {
+		delegatorInfo.PotentialReward = *delegator.PotentialReward
+	}
+	if delegator.RewardOwner != nil {
+		for _, addr := range delegator.RewardOwner.Addresses {
+			addrStr, err := address.Format("P", hrp, addr.Bytes())
+			if err != nil {
+				return nil, err
+			}
+			delegatorInfo.RewardsOwners = append(delegatorInfo.RewardsOwners, addrStr)
+		}
+	}
+	return delegatorInfo, nil
+}
+
 // Assumes [lc.lock] isn't held.
 func (lc *localNetwork) UpdateNodeInfo() error {
 	lc.lock.Lock()
@@ -501,6 +841,17 @@ func (lc *localNetwork) updateNodeInfo() error {
 			PluginDir:          node.GetPluginDir(),
 			WhitelistedSubnets: trackSubnets,
 			Paused:             node.GetPaused(),
+			ChainConfigs:       maps.Clone(node.GetConfig().ChainConfigFiles),
+			SubnetConfigs:      maps.Clone(node.GetConfig().SubnetConfigFiles),
+			Delegators:         lc.delegators[name],
+			Role:               node.GetConfig().Role.String(),
+		}
+		for _, peer := range node.GetBootstrapPeers() {
+			lc.nodeInfos[name].BootstrapPeers = append(lc.nodeInfos[name].BootstrapPeers, &rpcpb.BootstrapPeer{
+				Name: peer.Name,
+				Id:   peer.ID,
+				Ip:   peer.IP,
+			})
 		}
 
 		// update default exec and pluginDir if empty (snapshots started without these params)
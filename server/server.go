@@ -21,15 +21,20 @@ import (
 
 	"go.uber.org/multierr"
 
+	"github.com/ava-labs/avalanche-network-runner/local"
+	"github.com/ava-labs/avalanche-network-runner/local/binaries"
 	"github.com/ava-labs/avalanche-network-runner/network"
 	"github.com/ava-labs/avalanche-network-runner/network/node"
 	"github.com/ava-labs/avalanche-network-runner/rpcpb"
 	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanche-network-runner/utils/artifacts"
 	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"go.uber.org/zap"
 	"golang.org/x/exp/maps"
@@ -49,6 +54,7 @@ const (
 	stopTimeout           = 5 * time.Second
 	defaultStartTimeout   = 5 * time.Minute
 	waitForHealthyTimeout = 2 * time.Minute
+	apiCallTimeout        = time.Minute
 
 	rootDataDirPrefix = "network-runner-root-data"
 )
@@ -63,6 +69,8 @@ var (
 	ErrPeerNotFound           = errors.New("peer not found")
 	ErrStatusCanceled         = errors.New("gRPC stream status canceled")
 	ErrNoBlockchainSpec       = errors.New("no blockchain spec was provided")
+	ErrInvalidPortRange       = errors.New("invalid port range")
+	ErrNoBootstrapPeers       = errors.New("no bootstrap peers of the external network given")
 )
 
 type Config struct {
@@ -74,6 +82,13 @@ type Config struct {
 	RedirectNodesOutput bool
 	SnapshotsDir        string
 	LogLevel            logging.Level
+	// Dir of the registry of named binaries, the default one if empty
+	BinariesDir string
+	// Dir of the uploaded artifacts, the default one if empty
+	ArtifactsDir string
+	// Used to launch node processes, e.g. a fake backend in tests.
+	// If nil, camino-node binaries are executed.
+	NodeProcessCreator local.NodeProcessCreator
 }
 
 type Server interface {
@@ -103,6 +118,9 @@ type server struct {
 	network    *localNetwork
 	asyncErrCh chan error
 
+	binaries  *binaries.Registry
+	artifacts *artifacts.Store
+
 	rpcpb.UnimplementedPingServiceServer
 	rpcpb.UnimplementedControlServiceServer
 }
@@ -127,15 +145,9 @@ func New(cfg Config, log logging.Logger) (Server, error) {
 		return nil, err
 	}
 
-	s := &server{
-		cfg:        cfg,
-		log:        log,
-		closed:     make(chan struct{}),
-		ln:         listener,
-		gRPCServer: grpc.NewServer(),
-		mu:         new(sync.RWMutex),
-		asyncErrCh: make(chan error, 1),
-	}
+	s := newServer(cfg, log)
+	s.ln = listener
+	s.gRPCServer = grpc.NewServer()
 	if !cfg.GwDisabled {
 		s.gwMux = runtime.NewServeMux()
 		s.gwServer = &http.Server{ //nolint // TODO add ReadHeaderTimeout
@@ -147,6 +159,19 @@ func New(cfg Config, log logging.Logger) (Server, error) {
 	return s, nil
 }
 
+// Returns a server with the state shared by the gRPC and embedded servers.
+func newServer(cfg Config, log logging.Logger) *server {
+	return &server{
+		cfg:        cfg,
+		log:        log,
+		closed:     make(chan struct{}),
+		mu:         new(sync.RWMutex),
+		asyncErrCh: make(chan error, 1),
+		binaries:   binaries.New(cfg.BinariesDir),
+		artifacts:  artifacts.New(cfg.ArtifactsDir),
+	}
+}
+
 // Blocking call until server listeners return.
 func (s *server) Run(rootCtx context.Context) (err error) {
 	s.rootCtx, s.rootCancel = context.WithCancel(rootCtx)
@@ -269,15 +294,59 @@ func (s *server) Start(_ context.Context, req *rpcpb.StartRequest) (*rpcpb.Start
 		return nil, ErrNotEnoughNodesForStart
 	}
 
-	if err := utils.CheckExecPath(req.GetExecPath()); err != nil {
+	execPath, pluginDir, err := s.resolveBinary(req.GetExecPath(), req.GetPluginDir())
+	if err != nil {
+		return nil, err
+	}
+	if err := utils.CheckExecPath(execPath); err != nil {
+		return nil, err
+	}
+
+	portRange, err := getPortRange(req.GetPortRangeStart(), req.GetPortRangeEnd())
+	if err != nil {
+		return nil, err
+	}
+
+	upgradeSchedule := network.UpgradeSchedule{
+		CChainPhases: req.CChainPhases,
+	}
+	if err := upgradeSchedule.Validate(); err != nil {
+		return nil, err
+	}
+
+	validatorBond := network.ValidatorBond{
+		Amount:        req.GetValidatorBondAmount(),
+		RewardsOwner:  req.GetValidatorRewardsOwner(),
+		NodeOwnerKeys: req.NodeOwnerKeys,
+	}
+	if err := validatorBond.Validate(); err != nil {
+		return nil, err
+	}
+
+	depositOffers, err := network.ParseDepositOffers(req.GetDepositOffers())
+	if err != nil {
+		return nil, err
+	}
+
+	fundingKeyFile := req.GetFundingKeyFile()
+	if err := s.resolveArtifacts(&fundingKeyFile); err != nil {
+		return nil, err
+	}
+	keysCfg := network.Config{FundingKeys: req.FundingKeys, FundingKeyFile: fundingKeyFile, AdminKey: req.GetAdminKey()}
+	if _, err := keysCfg.GetFundingKeys(); err != nil {
+		return nil, err
+	}
+	if _, err := keysCfg.GetAdminKey(); err != nil {
 		return nil, err
 	}
 
-	pluginDir := req.GetPluginDir()
 	chainSpecs := []network.BlockchainSpec{}
 	if len(req.GetBlockchainSpecs()) > 0 {
 		s.log.Info("plugin-dir:", zap.String("plugin-dir", pluginDir))
 		for _, spec := range req.GetBlockchainSpecs() {
+			if err := s.resolveBlockchainSpecArtifacts(spec); err != nil {
+				return nil, err
+			}
 			chainSpec, err := getNetworkBlockchainSpec(s.log, spec, true, pluginDir)
 			if err != nil {
 				return nil, err
@@ -287,14 +356,12 @@ func (s *server) Start(_ context.Context, req *rpcpb.StartRequest) (*rpcpb.Start
 	}
 
 	var (
-		execPath          = req.GetExecPath()
 		numNodes          = req.GetNumNodes()
 		trackSubnets      = req.GetWhitelistedSubnets()
 		rootDataDir       = req.GetRootDataDir()
 		pid               = int32(os.Getpid())
 		globalNodeConfig  = req.GetGlobalNodeConfig()
 		customNodeConfigs = req.GetCustomNodeConfigs()
-		err               error
 	)
 
 	if len(rootDataDir) == 0 {
@@ -331,7 +398,18 @@ func (s *server) Start(_ context.Context, req *rpcpb.StartRequest) (*rpcpb.Start
 		logLevel:            s.cfg.LogLevel,
 		reassignPortsIfUsed: req.GetReassignPortsIfUsed(),
 		dynamicPorts:        req.GetDynamicPorts(),
+		portRange:           portRange,
+		nodeNamespaces:      req.GetNodeNamespaces(),
+		dedupSnapshots:      req.GetDedupSnapshots(),
+		snapshotKeys:        req.GetSnapshotKeys(),
+		upgradeSchedule:     upgradeSchedule,
+		validatorBond:       validatorBond,
+		depositOffers:       depositOffers,
+		fundingKeys:         req.FundingKeys,
+		fundingKeyFile:      fundingKeyFile,
+		adminKey:            req.GetAdminKey(),
 		snapshotsDir:        s.cfg.SnapshotsDir,
+		nodeProcessCreator:  s.cfg.NodeProcessCreator,
 	})
 	if err != nil {
 		return nil, err
@@ -377,6 +455,113 @@ func (s *server) Start(_ context.Context, req *rpcpb.StartRequest) (*rpcpb.Start
 	return &rpcpb.StartResponse{ClusterInfo: clusterInfo, ChainIds: strChainIDs}, nil
 }
 
+func (s *server) JoinExternalNetwork(_ context.Context, req *rpcpb.JoinExternalNetworkRequest) (*rpcpb.JoinExternalNetworkResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// If [network] is already populated, the network has already been started.
+	if s.network != nil {
+		return nil, ErrAlreadyBootstrapped
+	}
+
+	numNodes := MinNodes
+	if req.NumNodes != nil {
+		numNodes = req.GetNumNodes()
+	}
+	if numNodes < MinNodes {
+		return nil, ErrNotEnoughNodesForStart
+	}
+
+	execPath, pluginDir, err := s.resolveBinary(req.GetExecPath(), req.GetPluginDir())
+	if err != nil {
+		return nil, err
+	}
+	if err := utils.CheckExecPath(execPath); err != nil {
+		return nil, err
+	}
+
+	genesisNetworkID, err := utils.NetworkIDFromGenesis([]byte(req.GetGenesis()))
+	if err != nil {
+		return nil, fmt.Errorf("invalid external network genesis: %w", err)
+	}
+	if genesisNetworkID != req.GetNetworkId() {
+		return nil, fmt.Errorf("network ID %d doesn't match the network ID %d of the genesis", req.GetNetworkId(), genesisNetworkID)
+	}
+	if len(req.GetBootstrapPeers()) == 0 {
+		return nil, ErrNoBootstrapPeers
+	}
+	externalPeers := make([]node.BootstrapPeer, 0, len(req.GetBootstrapPeers()))
+	for _, peer := range req.GetBootstrapPeers() {
+		externalPeer := node.BootstrapPeer{ID: peer.Id, IP: peer.Ip}
+		if _, _, err := externalPeer.Parse(); err != nil {
+			return nil, err
+		}
+		externalPeers = append(externalPeers, externalPeer)
+	}
+
+	var (
+		trackSubnets     = req.GetWhitelistedSubnets()
+		rootDataDir      = req.GetRootDataDir()
+		pid              = int32(os.Getpid())
+		globalNodeConfig = req.GetGlobalNodeConfig()
+	)
+
+	if len(rootDataDir) == 0 {
+		rootDataDir = os.TempDir()
+	}
+	rootDataDir = filepath.Join(rootDataDir, rootDataDirPrefix)
+	rootDataDir, err = utils.MkDirWithTimestamp(rootDataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	s.clusterInfo = &rpcpb.ClusterInfo{
+		Pid:         pid,
+		RootDataDir: rootDataDir,
+	}
+
+	s.network, err = newLocalNetwork(localNetworkOptions{
+		execPath:            execPath,
+		rootDataDir:         rootDataDir,
+		numNodes:            numNodes,
+		trackSubnets:        trackSubnets,
+		redirectNodesOutput: s.cfg.RedirectNodesOutput,
+		pluginDir:           pluginDir,
+		globalNodeConfig:    globalNodeConfig,
+		chainConfigs:        req.ChainConfigs,
+		logLevel:            s.cfg.LogLevel,
+		snapshotsDir:        s.cfg.SnapshotsDir,
+		nodeProcessCreator:  s.cfg.NodeProcessCreator,
+		externalGenesis:     req.GetGenesis(),
+		externalPeers:       externalPeers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info("joining external network",
+		zap.String("exec-path", execPath),
+		zap.Uint32("num-nodes", numNodes),
+		zap.Uint32("network-id", genesisNetworkID),
+		zap.Int("bootstrap-peers", len(externalPeers)),
+		zap.Int32("pid", pid),
+		zap.String("root-data-dir", rootDataDir),
+	)
+
+	if err := s.network.Start(); err != nil {
+		s.log.Warn("join failed to complete", zap.Error(err))
+		s.stopAndRemoveNetwork(nil)
+		return nil, err
+	}
+	s.updateClusterInfo()
+
+	clusterInfo, err := deepCopy(s.clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.JoinExternalNetworkResponse{ClusterInfo: clusterInfo}, nil
+}
+
 // Asssumes [s.mu] is held.
 func (s *server) updateClusterInfo() {
 	if s.network == nil {
@@ -397,6 +582,29 @@ func (s *server) updateClusterInfo() {
 	for subnetID, nodes := range s.network.subnetParticipants {
 		s.clusterInfo.SubnetParticipants[subnetID] = &rpcpb.SubnetParticipants{NodeNames: nodes}
 	}
+	s.clusterInfo.Upgrades = make([]*rpcpb.UpgradeInfo, 0, len(s.network.upgrades))
+	for _, upgrade := range s.network.upgrades {
+		s.clusterInfo.Upgrades = append(s.clusterInfo.Upgrades, &rpcpb.UpgradeInfo{
+			Chain: upgrade.Chain,
+			Name:  upgrade.Name,
+			Time:  upgrade.Time.Unix(),
+		})
+	}
+	setActiveUpgrades(s.clusterInfo, time.Now())
+	s.clusterInfo.ExternalPeers = make([]*rpcpb.BootstrapPeer, 0, len(s.network.externalPeers))
+	for _, peer := range s.network.externalPeers {
+		s.clusterInfo.ExternalPeers = append(s.clusterInfo.ExternalPeers, &rpcpb.BootstrapPeer{
+			Id: peer.ID,
+			Ip: peer.IP,
+		})
+	}
+}
+
+// Sets which upgrades of [clusterInfo] are active at [now].
+func setActiveUpgrades(clusterInfo *rpcpb.ClusterInfo, now time.Time) {
+	for _, upgrade := range clusterInfo.Upgrades {
+		upgrade.Active = now.Unix() >= upgrade.Time
+	}
 }
 
 // wait until some of this conditions is met:
@@ -469,6 +677,9 @@ func (s *server) CreateBlockchains(
 
 	chainSpecs := []network.BlockchainSpec{}
 	for _, spec := range req.GetBlockchainSpecs() {
+		if err := s.resolveBlockchainSpecArtifacts(spec); err != nil {
+			return nil, err
+		}
 		chainSpec, err := getNetworkBlockchainSpec(s.log, spec, false, s.network.pluginDir)
 		if err != nil {
 			return nil, err
@@ -525,6 +736,9 @@ func (s *server) CreateSubnets(_ context.Context, req *rpcpb.CreateSubnetsReques
 
 	subnetSpecs := []network.SubnetSpec{}
 	for _, spec := range req.GetSubnetSpecs() {
+		if err := s.resolveArtifacts(&spec.SubnetConfig); err != nil {
+			return nil, err
+		}
 		subnetSpec, err := getNetworkSubnetSpec(spec)
 		if err != nil {
 			return nil, err
@@ -617,7 +831,16 @@ func (s *server) Status(context.Context, *rpcpb.StatusRequest) (*rpcpb.StatusRes
 		return &rpcpb.StatusResponse{}, ErrNotBootstrapped
 	}
 
-	return &rpcpb.StatusResponse{ClusterInfo: s.clusterInfo}, nil
+	if len(s.clusterInfo.Upgrades) == 0 {
+		return &rpcpb.StatusResponse{ClusterInfo: s.clusterInfo}, nil
+	}
+	// upgrades may have activated since the last update
+	clusterInfo, err := deepCopy(s.clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	setActiveUpgrades(clusterInfo, time.Now())
+	return &rpcpb.StatusResponse{ClusterInfo: clusterInfo}, nil
 }
 
 // Assumes [s.mu] is held.
@@ -760,19 +983,37 @@ func (s *server) AddNode(_ context.Context, req *rpcpb.AddNodeRequest) (*rpcpb.A
 		}
 	}
 
-	if req.GetPluginDir() != "" {
-		nodeFlags[config.PluginDirKey] = req.GetPluginDir()
+	execPath, pluginDir, err := s.resolveBinary(req.GetExecPath(), req.GetPluginDir())
+	if err != nil {
+		return nil, err
+	}
+	if pluginDir != "" {
+		nodeFlags[config.PluginDirKey] = pluginDir
+	}
+
+	validatorConfig, err := GetValidatorConfig(req.GetValidatorSpec())
+	if err != nil {
+		return nil, err
 	}
 
 	nodeConfig := node.Config{
 		Name:               req.Name,
 		Flags:              nodeFlags,
-		BinaryPath:         req.GetExecPath(),
+		BinaryPath:         execPath,
 		RedirectStdout:     s.cfg.RedirectNodesOutput,
 		RedirectStderr:     s.cfg.RedirectNodesOutput,
 		ChainConfigFiles:   req.ChainConfigs,
 		UpgradeConfigFiles: req.UpgradeConfigs,
 		SubnetConfigFiles:  req.SubnetConfigs,
+		Validator:          validatorConfig,
+		Role:               node.Role(req.Role),
+		BootstrapNodes:     req.BootstrapNodes,
+	}
+	for _, peer := range req.BootstrapPeers {
+		nodeConfig.BootstrapPeers = append(nodeConfig.BootstrapPeers, node.BootstrapPeer{
+			ID: peer.Id,
+			IP: peer.Ip,
+		})
 	}
 
 	if _, err := s.network.nw.AddNode(nodeConfig); err != nil {
@@ -833,11 +1074,16 @@ func (s *server) RestartNode(ctx context.Context, req *rpcpb.RestartNodeRequest)
 		return nil, ErrNotBootstrapped
 	}
 
+	execPath, pluginDir, err := s.resolveBinary(req.GetExecPath(), req.GetPluginDir())
+	if err != nil {
+		return nil, err
+	}
+
 	if err := s.network.nw.RestartNode(
 		ctx,
 		req.Name,
-		req.GetExecPath(),
-		req.GetPluginDir(),
+		execPath,
+		pluginDir,
 		req.GetWhitelistedSubnets(),
 		req.GetChainConfigs(),
 		req.GetUpgradeConfigs(),
@@ -861,150 +1107,590 @@ func (s *server) RestartNode(ctx context.Context, req *rpcpb.RestartNodeRequest)
 	return &rpcpb.RestartNodeResponse{ClusterInfo: clusterInfo}, nil
 }
 
-func (s *server) PauseNode(ctx context.Context, req *rpcpb.PauseNodeRequest) (*rpcpb.PauseNodeResponse, error) {
+func (s *server) ReplaceVMPlugin(_ context.Context, req *rpcpb.ReplaceVMPluginRequest) (*rpcpb.ReplaceVMPluginResponse, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	s.log.Debug("PauseNode", zap.String("name", req.Name))
+	s.log.Debug("ReplaceVMPlugin", zap.String("vm-name", req.VmName))
 
 	if s.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	if err := s.network.nw.PauseNode(
-		ctx,
-		req.Name,
-	); err != nil {
+	vmID, err := utils.VMID(req.VmName)
+	if err != nil {
+		s.log.Warn("failed to convert VM name to VM ID", zap.String("vm-name", req.VmName), zap.Error(err))
+		return nil, ErrInvalidVMName
+	}
+	pluginPath := req.PluginPath
+	if err := s.resolveArtifacts(&pluginPath); err != nil {
 		return nil, err
 	}
-
-	if err := s.network.updateNodeInfo(); err != nil {
+	if err := utils.CheckExecPath(pluginPath); err != nil {
 		return nil, err
 	}
 
-	s.clusterInfo.NodeNames = maps.Keys(s.network.nodeInfos)
-	sort.Strings(s.clusterInfo.NodeNames)
-	s.clusterInfo.NodeInfos = s.network.nodeInfos
+	s.clusterInfo.Healthy = false
+	s.clusterInfo.CustomChainsHealthy = false
 
-	return &rpcpb.PauseNodeResponse{ClusterInfo: s.clusterInfo}, nil
+	ctx, cancel := context.WithTimeout(context.Background(), waitForHealthyTimeout)
+	defer cancel()
+	restarted, err := s.network.ReplaceVMPlugin(ctx, vmID, pluginPath)
+	if err != nil {
+		s.log.Error("failed to replace vm plugin", zap.Error(err))
+		return nil, err
+	}
+	s.updateClusterInfo()
+	s.log.Info("vm plugin replaced", zap.String("vm-id", vmID.String()), zap.Strings("restarted-nodes", restarted))
+
+	clusterInfo, err := deepCopy(s.clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.ReplaceVMPluginResponse{ClusterInfo: clusterInfo, RestartedNodes: restarted}, nil
 }
 
-func (s *server) ResumeNode(ctx context.Context, req *rpcpb.ResumeNodeRequest) (*rpcpb.ResumeNodeResponse, error) {
+func (s *server) UpdateChainConfig(_ context.Context, req *rpcpb.UpdateChainConfigRequest) (*rpcpb.UpdateChainConfigResponse, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	s.log.Debug("ResumeNode", zap.String("name", req.Name))
+	s.log.Debug("UpdateChainConfig", zap.String("chain-id", req.ChainId))
 
 	if s.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	if err := s.network.nw.ResumeNode(
-		ctx,
-		req.Name,
-	); err != nil {
-		return nil, err
+	chainID, err := ids.FromString(req.ChainId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid blockchain ID %q: %w", req.ChainId, err)
+	}
+	perNodeChainConfig := map[string][]byte{}
+	for nodeName, cfg := range req.PerNodeChainConfig {
+		perNodeChainConfig[nodeName] = []byte(cfg)
 	}
 
-	if err := s.network.updateNodeInfo(); err != nil {
+	ctx, cancel := context.WithTimeout(context.Background(), waitForHealthyTimeout)
+	defer cancel()
+	restarted, err := s.network.UpdateChainConfig(ctx, chainID, []byte(req.ChainConfig), perNodeChainConfig)
+	if err != nil {
+		s.log.Error("failed to update chain config", zap.Error(err))
 		return nil, err
 	}
+	s.updateClusterInfo()
+	s.log.Info("chain config updated", zap.String("chain-id", req.ChainId), zap.Strings("restarted-nodes", restarted))
 
-	s.clusterInfo.NodeNames = maps.Keys(s.network.nodeInfos)
-	sort.Strings(s.clusterInfo.NodeNames)
-	s.clusterInfo.NodeInfos = s.network.nodeInfos
-
-	return &rpcpb.ResumeNodeResponse{ClusterInfo: s.clusterInfo}, nil
-}
-
-func (s *server) Stop(context.Context, *rpcpb.StopRequest) (*rpcpb.StopResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	s.log.Debug("Stop")
-
-	s.stopAndRemoveNetwork(nil)
-
-	return &rpcpb.StopResponse{ClusterInfo: s.clusterInfo}, nil
-}
-
-var _ router.InboundHandler = &loggingInboundHandler{}
-
-type loggingInboundHandler struct {
-	nodeName string
-	log      logging.Logger
-}
-
-func (lh *loggingInboundHandler) HandleInbound(_ context.Context, m message.InboundMessage) {
-	lh.log.Debug(
-		"inbound handler received a message",
-		zap.String("message", m.Op().String()),
-		zap.String("node-name", lh.nodeName),
-	)
+	clusterInfo, err := deepCopy(s.clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.UpdateChainConfigResponse{ClusterInfo: clusterInfo, RestartedNodes: restarted}, nil
 }
 
-func (s *server) AttachPeer(ctx context.Context, req *rpcpb.AttachPeerRequest) (*rpcpb.AttachPeerResponse, error) {
+func (s *server) UpdateSubnetConfig(_ context.Context, req *rpcpb.UpdateSubnetConfigRequest) (*rpcpb.UpdateSubnetConfigResponse, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	s.log.Debug("AttachPeer")
+	s.log.Debug("UpdateSubnetConfig", zap.String("subnet-id", req.SubnetId))
 
 	if s.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	node, err := s.network.nw.GetNode(req.NodeName)
+	subnetID, err := ids.FromString(req.SubnetId)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid subnet ID %q: %w", req.SubnetId, err)
 	}
 
-	loggingHandler := &loggingInboundHandler{nodeName: req.NodeName, log: s.log}
-	newPeer, err := node.AttachPeer(ctx, loggingHandler)
+	ctx, cancel := context.WithTimeout(context.Background(), waitForHealthyTimeout)
+	defer cancel()
+	restarted, err := s.network.UpdateSubnetConfig(ctx, subnetID, []byte(req.SubnetConfig))
 	if err != nil {
+		s.log.Error("failed to update subnet config", zap.Error(err))
 		return nil, err
 	}
-
-	newPeerID := newPeer.ID().String()
-	s.log.Debug("new peer is attached to", zap.String("peer-ID", newPeerID), zap.String("node-name", node.GetName()))
-
-	if s.clusterInfo.AttachedPeerInfos == nil {
-		s.clusterInfo.AttachedPeerInfos = make(map[string]*rpcpb.ListOfAttachedPeerInfo)
-	}
-	peerInfo := &rpcpb.AttachedPeerInfo{Id: newPeerID}
-	if v, ok := s.clusterInfo.AttachedPeerInfos[req.NodeName]; ok {
-		v.Peers = append(v.Peers, peerInfo)
-	} else {
-		s.clusterInfo.AttachedPeerInfos[req.NodeName] = &rpcpb.ListOfAttachedPeerInfo{
-			Peers: []*rpcpb.AttachedPeerInfo{peerInfo},
-		}
-	}
+	s.updateClusterInfo()
+	s.log.Info("subnet config updated", zap.String("subnet-id", req.SubnetId), zap.Strings("restarted-nodes", restarted))
 
 	clusterInfo, err := deepCopy(s.clusterInfo)
 	if err != nil {
 		return nil, err
 	}
-	return &rpcpb.AttachPeerResponse{ClusterInfo: clusterInfo, AttachedPeerInfo: peerInfo}, nil
+	return &rpcpb.UpdateSubnetConfigResponse{ClusterInfo: clusterInfo, RestartedNodes: restarted}, nil
 }
 
-func (s *server) SendOutboundMessage(ctx context.Context, req *rpcpb.SendOutboundMessageRequest) (*rpcpb.SendOutboundMessageResponse, error) {
+func (s *server) SetAddressState(ctx context.Context, req *rpcpb.SetAddressStateRequest) (*rpcpb.SetAddressStateResponse, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	s.log.Debug("SendOutboundMessage")
+	s.log.Debug("SetAddressState",
+		zap.String("address", req.Address),
+		zap.String("state", req.State),
+		zap.Bool("remove", req.Remove),
+	)
 
 	if s.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	node, err := s.network.nw.GetNode(req.NodeName)
+	state, err := network.ParseAddressState(req.State)
 	if err != nil {
 		return nil, err
 	}
+	txID, err := s.network.nw.SetAddressState(ctx, req.Address, state, req.Remove)
+	if err != nil {
+		s.log.Error("failed to set address state", zap.Error(err))
+		return nil, err
+	}
+	states, err := s.network.nw.GetAddressState(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterInfo, err := deepCopy(s.clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.SetAddressStateResponse{
+		ClusterInfo: clusterInfo,
+		TxId:        txID.String(),
+		States:      states,
+		StateNames:  network.AddressStateNames(states),
+	}, nil
+}
+
+func (s *server) GetAddressState(ctx context.Context, req *rpcpb.GetAddressStateRequest) (*rpcpb.GetAddressStateResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	s.log.Debug("GetAddressState", zap.String("address", req.Address))
+
+	if s.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	states, err := s.network.nw.GetAddressState(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterInfo, err := deepCopy(s.clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.GetAddressStateResponse{
+		ClusterInfo: clusterInfo,
+		States:      states,
+		StateNames:  network.AddressStateNames(states),
+	}, nil
+}
+
+func (s *server) GetDepositOffers(ctx context.Context, _ *rpcpb.GetDepositOffersRequest) (*rpcpb.GetDepositOffersResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	s.log.Debug("GetDepositOffers")
+
+	if s.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	offers, err := s.network.nw.GetDepositOffers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterInfo, err := deepCopy(s.clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.GetDepositOffersResponse{
+		ClusterInfo:   clusterInfo,
+		DepositOffers: ToRPCDepositOffers(offers),
+	}, nil
+}
+
+func (s *server) Deposit(ctx context.Context, req *rpcpb.DepositRequest) (*rpcpb.DepositResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.log.Debug("Deposit",
+		zap.String("deposit-offer-id", req.DepositOfferId),
+		zap.Uint64("amount", req.Amount),
+		zap.Uint32("duration", req.Duration),
+	)
+
+	if s.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	offerID, err := ids.FromString(req.DepositOfferId)
+	if err != nil {
+		return nil, err
+	}
+	txID, err := s.network.nw.Deposit(ctx, offerID, req.Amount, req.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterInfo, err := deepCopy(s.clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.DepositResponse{
+		ClusterInfo: clusterInfo,
+		TxId:        txID.String(),
+	}, nil
+}
+
+func (s *server) ClaimRewards(ctx context.Context, req *rpcpb.ClaimRewardsRequest) (*rpcpb.ClaimRewardsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.log.Debug("ClaimRewards", zap.Strings("deposit-tx-ids", req.DepositTxIds))
+
+	if s.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	depositTxIDs, err := ParseIDs(req.DepositTxIds)
+	if err != nil {
+		return nil, err
+	}
+	txID, amount, err := s.network.nw.ClaimRewards(ctx, depositTxIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterInfo, err := deepCopy(s.clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.ClaimRewardsResponse{
+		ClusterInfo: clusterInfo,
+		TxId:        txID.String(),
+		Amount:      amount,
+	}, nil
+}
+
+func (s *server) UnlockDeposit(ctx context.Context, req *rpcpb.UnlockDepositRequest) (*rpcpb.UnlockDepositResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.log.Debug("UnlockDeposit", zap.Strings("deposit-tx-ids", req.DepositTxIds))
+
+	if s.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	depositTxIDs, err := ParseIDs(req.DepositTxIds)
+	if err != nil {
+		return nil, err
+	}
+	txID, amount, err := s.network.nw.UnlockDeposit(ctx, depositTxIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterInfo, err := deepCopy(s.clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.UnlockDepositResponse{
+		ClusterInfo: clusterInfo,
+		TxId:        txID.String(),
+		Amount:      amount,
+	}, nil
+}
+
+func (s *server) CreateMultisigAlias(ctx context.Context, req *rpcpb.CreateMultisigAliasRequest) (*rpcpb.CreateMultisigAliasResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.log.Debug("CreateMultisigAlias",
+		zap.Uint32("threshold", req.Threshold),
+		zap.Strings("addresses", req.Addresses),
+	)
+
+	if s.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	txID, alias, err := s.network.nw.CreateMultisigAlias(ctx, req.Threshold, req.Addresses, req.Memo)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterInfo, err := deepCopy(s.clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.CreateMultisigAliasResponse{
+		ClusterInfo: clusterInfo,
+		TxId:        txID.String(),
+		Alias:       alias,
+	}, nil
+}
+
+func (s *server) GrantCChainRole(ctx context.Context, req *rpcpb.CChainRoleRequest) (*rpcpb.CChainRoleResponse, error) {
+	return s.setCChainRole(ctx, req, false)
+}
+
+func (s *server) RevokeCChainRole(ctx context.Context, req *rpcpb.CChainRoleRequest) (*rpcpb.CChainRoleResponse, error) {
+	return s.setCChainRole(ctx, req, true)
+}
+
+func (s *server) setCChainRole(ctx context.Context, req *rpcpb.CChainRoleRequest, revoke bool) (*rpcpb.CChainRoleResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.log.Debug("SetCChainRole",
+		zap.String("address", req.Address),
+		zap.String("role", req.Role),
+		zap.Bool("revoke", revoke),
+	)
+
+	if s.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	role, err := network.ParseCChainRole(req.Role)
+	if err != nil {
+		return nil, err
+	}
+	var txHash common.Hash
+	if req.Role == network.CChainDeployerRole {
+		txHash, err = s.network.nw.SetCChainDeployer(ctx, req.Address, !revoke)
+	} else {
+		txHash, err = s.network.nw.SetCChainRole(ctx, req.Address, role, revoke)
+	}
+	if err != nil {
+		s.log.Error("failed to set C-chain role", zap.Error(err))
+		return nil, err
+	}
+	roles, deployer, err := s.network.nw.GetCChainRoles(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterInfo, err := deepCopy(s.clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.CChainRoleResponse{
+		ClusterInfo: clusterInfo,
+		TxHash:      txHash.Hex(),
+		Roles:       roles,
+		RoleNames:   network.CChainRoleNames(roles, deployer),
+	}, nil
+}
+
+func (s *server) GetCChainRoles(ctx context.Context, req *rpcpb.GetCChainRolesRequest) (*rpcpb.GetCChainRolesResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	s.log.Debug("GetCChainRoles", zap.String("address", req.Address))
+
+	if s.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	roles, deployer, err := s.network.nw.GetCChainRoles(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterInfo, err := deepCopy(s.clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.GetCChainRolesResponse{
+		ClusterInfo: clusterInfo,
+		Roles:       roles,
+		RoleNames:   network.CChainRoleNames(roles, deployer),
+	}, nil
+}
+
+func (s *server) AddDelegator(ctx context.Context, req *rpcpb.AddDelegatorRequest) (*rpcpb.AddDelegatorResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.log.Debug("AddDelegator",
+		zap.String("node-name", req.NodeName),
+		zap.String("subnet-id", req.GetSubnetId()),
+		zap.Uint64("amount", req.Amount),
+		zap.String("duration", req.Duration),
+	)
+
+	if s.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	spec, err := GetDelegatorSpec(req)
+	if err != nil {
+		return nil, err
+	}
+	txID, err := s.network.nw.AddDelegator(ctx, req.NodeName, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	s.network.UpdateDelegatorInfo(ctx)
+	s.clusterInfo.NodeInfos = s.network.nodeInfos
+
+	clusterInfo, err := deepCopy(s.clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.AddDelegatorResponse{
+		ClusterInfo: clusterInfo,
+		TxId:        txID.String(),
+	}, nil
+}
+
+func (s *server) PauseNode(ctx context.Context, req *rpcpb.PauseNodeRequest) (*rpcpb.PauseNodeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.log.Debug("PauseNode", zap.String("name", req.Name))
+
+	if s.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	if err := s.network.nw.PauseNode(
+		ctx,
+		req.Name,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := s.network.updateNodeInfo(); err != nil {
+		return nil, err
+	}
+
+	s.clusterInfo.NodeNames = maps.Keys(s.network.nodeInfos)
+	sort.Strings(s.clusterInfo.NodeNames)
+	s.clusterInfo.NodeInfos = s.network.nodeInfos
+
+	return &rpcpb.PauseNodeResponse{ClusterInfo: s.clusterInfo}, nil
+}
+
+func (s *server) ResumeNode(ctx context.Context, req *rpcpb.ResumeNodeRequest) (*rpcpb.ResumeNodeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.log.Debug("ResumeNode", zap.String("name", req.Name))
+
+	if s.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	if err := s.network.nw.ResumeNode(
+		ctx,
+		req.Name,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := s.network.updateNodeInfo(); err != nil {
+		return nil, err
+	}
+
+	s.clusterInfo.NodeNames = maps.Keys(s.network.nodeInfos)
+	sort.Strings(s.clusterInfo.NodeNames)
+	s.clusterInfo.NodeInfos = s.network.nodeInfos
+
+	return &rpcpb.ResumeNodeResponse{ClusterInfo: s.clusterInfo}, nil
+}
+
+func (s *server) Stop(context.Context, *rpcpb.StopRequest) (*rpcpb.StopResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.log.Debug("Stop")
+
+	s.stopAndRemoveNetwork(nil)
+
+	return &rpcpb.StopResponse{ClusterInfo: s.clusterInfo}, nil
+}
+
+var _ router.InboundHandler = &loggingInboundHandler{}
+
+type loggingInboundHandler struct {
+	nodeName string
+	log      logging.Logger
+}
+
+func (lh *loggingInboundHandler) HandleInbound(_ context.Context, m message.InboundMessage) {
+	lh.log.Debug(
+		"inbound handler received a message",
+		zap.String("message", m.Op().String()),
+		zap.String("node-name", lh.nodeName),
+	)
+}
+
+func (s *server) AttachPeer(ctx context.Context, req *rpcpb.AttachPeerRequest) (*rpcpb.AttachPeerResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.log.Debug("AttachPeer")
+
+	if s.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	node, err := s.network.nw.GetNode(req.NodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	loggingHandler := &loggingInboundHandler{nodeName: req.NodeName, log: s.log}
+	newPeer, err := node.AttachPeer(ctx, loggingHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	newPeerID := newPeer.ID().String()
+	s.log.Debug("new peer is attached to", zap.String("peer-ID", newPeerID), zap.String("node-name", node.GetName()))
+
+	if s.clusterInfo.AttachedPeerInfos == nil {
+		s.clusterInfo.AttachedPeerInfos = make(map[string]*rpcpb.ListOfAttachedPeerInfo)
+	}
+	peerInfo := &rpcpb.AttachedPeerInfo{Id: newPeerID}
+	if v, ok := s.clusterInfo.AttachedPeerInfos[req.NodeName]; ok {
+		v.Peers = append(v.Peers, peerInfo)
+	} else {
+		s.clusterInfo.AttachedPeerInfos[req.NodeName] = &rpcpb.ListOfAttachedPeerInfo{
+			Peers: []*rpcpb.AttachedPeerInfo{peerInfo},
+		}
+	}
+
+	clusterInfo, err := deepCopy(s.clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.AttachPeerResponse{ClusterInfo: clusterInfo, AttachedPeerInfo: peerInfo}, nil
+}
+
+func (s *server) SendOutboundMessage(ctx context.Context, req *rpcpb.SendOutboundMessageRequest) (*rpcpb.SendOutboundMessageResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.log.Debug("SendOutboundMessage")
+
+	if s.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	node, err := s.network.nw.GetNode(req.NodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	sent, err := node.SendOutboundMessage(ctx, req.PeerId, req.Bytes, req.Op)
+	return &rpcpb.SendOutboundMessageResponse{Sent: sent}, err
+}
 
-	sent, err := node.SendOutboundMessage(ctx, req.PeerId, req.Bytes, req.Op)
-	return &rpcpb.SendOutboundMessageResponse{Sent: sent}, err
-}
-
 func (s *server) LoadSnapshot(_ context.Context, req *rpcpb.LoadSnapshotRequest) (*rpcpb.LoadSnapshotResponse, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -1026,12 +1712,17 @@ func (s *server) LoadSnapshot(_ context.Context, req *rpcpb.LoadSnapshotRequest)
 		return nil, err
 	}
 
+	execPath, pluginDir, err := s.resolveBinary(req.GetExecPath(), req.GetPluginDir())
+	if err != nil {
+		return nil, err
+	}
+
 	pid := int32(os.Getpid())
 	s.log.Info("starting", zap.Int32("pid", pid), zap.String("root-data-dir", rootDataDir))
 
 	s.network, err = newLocalNetwork(localNetworkOptions{
-		execPath:            req.GetExecPath(),
-		pluginDir:           req.GetPluginDir(),
+		execPath:            execPath,
+		pluginDir:           pluginDir,
 		rootDataDir:         rootDataDir,
 		chainConfigs:        req.ChainConfigs,
 		upgradeConfigs:      req.UpgradeConfigs,
@@ -1040,6 +1731,7 @@ func (s *server) LoadSnapshot(_ context.Context, req *rpcpb.LoadSnapshotRequest)
 		logLevel:            s.cfg.LogLevel,
 		reassignPortsIfUsed: req.GetReassignPortsIfUsed(),
 		snapshotsDir:        s.cfg.SnapshotsDir,
+		nodeProcessCreator:  s.cfg.NodeProcessCreator,
 	})
 	if err != nil {
 		return nil, err
@@ -1055,6 +1747,7 @@ func (s *server) LoadSnapshot(_ context.Context, req *rpcpb.LoadSnapshotRequest)
 		s.stopAndRemoveNetwork(nil)
 		return nil, err
 	}
+	dbCopies := s.network.nw.GetSnapshotDBCopies()
 
 	// update cluster info non-blocking
 	// the user is expected to poll this latest information
@@ -1079,7 +1772,10 @@ func (s *server) LoadSnapshot(_ context.Context, req *rpcpb.LoadSnapshotRequest)
 	if err != nil {
 		return nil, err
 	}
-	return &rpcpb.LoadSnapshotResponse{ClusterInfo: clusterInfo}, nil
+	return &rpcpb.LoadSnapshotResponse{
+		ClusterInfo: clusterInfo,
+		DbCopies:    toRPCDBCopies(dbCopies),
+	}, nil
 }
 
 func (s *server) SaveSnapshot(ctx context.Context, req *rpcpb.SaveSnapshotRequest) (*rpcpb.SaveSnapshotResponse, error) {
@@ -1098,9 +1794,14 @@ func (s *server) SaveSnapshot(ctx context.Context, req *rpcpb.SaveSnapshotReques
 		return nil, err
 	}
 
+	dbCopies := s.network.nw.GetSnapshotDBCopies()
+
 	s.stopAndRemoveNetwork(nil)
 
-	return &rpcpb.SaveSnapshotResponse{SnapshotPath: snapshotPath}, nil
+	return &rpcpb.SaveSnapshotResponse{
+		SnapshotPath: snapshotPath,
+		DbCopies:     toRPCDBCopies(dbCopies),
+	}, nil
 }
 
 func (s *server) RemoveSnapshot(_ context.Context, req *rpcpb.RemoveSnapshotRequest) (*rpcpb.RemoveSnapshotResponse, error) {
@@ -1137,6 +1838,156 @@ func (s *server) GetSnapshotNames(context.Context, *rpcpb.GetSnapshotNamesReques
 	return &rpcpb.GetSnapshotNamesResponse{SnapshotNames: snapshotNames}, nil
 }
 
+func (s *server) MigrateSnapshot(_ context.Context, req *rpcpb.MigrateSnapshotRequest) (*rpcpb.MigrateSnapshotResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.log.Info("MigrateSnapshot", zap.String("snapshot-name", req.SnapshotName))
+
+	// snapshots are migrated in place, a network is not needed
+	result, err := local.MigrateSnapshot(s.cfg.SnapshotsDir, req.SnapshotName)
+	if err != nil {
+		s.log.Warn("snapshot migration failed to complete", zap.Error(err))
+		return nil, err
+	}
+	return &rpcpb.MigrateSnapshotResponse{
+		FromVersion:       result.FromVersion,
+		ToVersion:         result.ToVersion,
+		AppliedMigrations: result.Applied,
+	}, nil
+}
+
+func (s *server) RegisterBinary(_ context.Context, req *rpcpb.RegisterBinaryRequest) (*rpcpb.RegisterBinaryResponse, error) {
+	s.log.Info("RegisterBinary", zap.String("name", req.Name), zap.String("exec-path", req.ExecPath))
+
+	execPath, pluginDir := req.ExecPath, req.PluginDir
+	if err := s.resolveArtifacts(&execPath, &pluginDir); err != nil {
+		return nil, err
+	}
+	// the registry is safe for concurrent use, no need to hold the lock
+	binary, err := s.binaries.Register(req.Name, execPath, pluginDir)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.RegisterBinaryResponse{Binary: newBinaryInfo(binary)}, nil
+}
+
+func (s *server) ListBinaries(context.Context, *rpcpb.ListBinariesRequest) (*rpcpb.ListBinariesResponse, error) {
+	s.log.Debug("ListBinaries")
+
+	registered, err := s.binaries.List()
+	if err != nil {
+		return nil, err
+	}
+	binaryInfos := make([]*rpcpb.BinaryInfo, 0, len(registered))
+	for _, binary := range registered {
+		binaryInfos = append(binaryInfos, newBinaryInfo(binary))
+	}
+	return &rpcpb.ListBinariesResponse{Binaries: binaryInfos}, nil
+}
+
+func (s *server) RemoveBinary(_ context.Context, req *rpcpb.RemoveBinaryRequest) (*rpcpb.RemoveBinaryResponse, error) {
+	s.log.Info("RemoveBinary", zap.String("name", req.Name))
+
+	if err := s.binaries.Remove(req.Name); err != nil {
+		return nil, err
+	}
+	return &rpcpb.RemoveBinaryResponse{}, nil
+}
+
+func (s *server) UploadArtifact(stream rpcpb.ControlService_UploadArtifactServer) error {
+	s.log.Debug("UploadArtifact")
+
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		// empty contents
+		first = &rpcpb.UploadArtifactRequest{}
+	} else if err != nil {
+		return err
+	}
+	r := &uploadReader{stream: stream, buf: first.Chunk, eof: err != nil}
+	// the store is safe for concurrent use, no need to hold the lock
+	id, size, err := s.artifacts.Add(r, first.IsDir)
+	if err != nil {
+		s.log.Warn("artifact upload failed", zap.Error(err))
+		return err
+	}
+	s.log.Info("artifact uploaded", zap.String("id", id), zap.Int64("size", size), zap.Bool("is-dir", first.IsDir))
+	return stream.SendAndClose(&rpcpb.UploadArtifactResponse{
+		ArtifactId: id,
+		Ref:        artifacts.Ref(id),
+		Size:       uint64(size),
+	})
+}
+
+// Reads the chunks of an artifact upload stream.
+type uploadReader struct {
+	stream rpcpb.ControlService_UploadArtifactServer
+	buf    []byte
+	eof    bool
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.eof {
+			return 0, io.EOF
+		}
+		req, err := r.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			r.eof = true
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		r.buf = req.Chunk
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// Replaces the artifact references in [paths] by the paths of the artifacts.
+func (s *server) resolveArtifacts(paths ...*string) error {
+	for _, path := range paths {
+		resolved, err := s.artifacts.Resolve(*path)
+		if err != nil {
+			return err
+		}
+		*path = resolved
+	}
+	return nil
+}
+
+// Replaces the artifact references in the file paths of [spec].
+func (s *server) resolveBlockchainSpecArtifacts(spec *rpcpb.BlockchainSpec) error {
+	if spec.SubnetSpec != nil {
+		if err := s.resolveArtifacts(&spec.SubnetSpec.SubnetConfig); err != nil {
+			return err
+		}
+	}
+	return s.resolveArtifacts(&spec.Genesis, &spec.ChainConfig, &spec.NetworkUpgrade, &spec.PerNodeChainConfig)
+}
+
+// Returns the exec path and plugin dir to use for [execPath] and [pluginDir],
+// which may be artifact references, or the name of a registered binary.
+func (s *server) resolveBinary(execPath string, pluginDir string) (string, string, error) {
+	if err := s.resolveArtifacts(&execPath, &pluginDir); err != nil {
+		return "", "", err
+	}
+	return s.binaries.Resolve(execPath, pluginDir)
+}
+
+func newBinaryInfo(binary binaries.Binary) *rpcpb.BinaryInfo {
+	return &rpcpb.BinaryInfo{
+		Name:      binary.Name,
+		ExecPath:  binary.ExecPath,
+		PluginDir: binary.PluginDir,
+		Hash:      binary.Hash,
+		Version:   binary.Version,
+	}
+}
+
 func isClientCanceled(ctxErr error, err error) bool {
 	if ctxErr != nil {
 		return true
@@ -1259,8 +2110,18 @@ func getNetworkBlockchainSpec(
 
 	if spec.SubnetSpec != nil {
 		subnetSpec := network.SubnetSpec{
-			Participants: spec.SubnetSpec.Participants,
-			SubnetConfig: subnetConfigBytes,
+			Participants:       spec.SubnetSpec.Participants,
+			SubnetConfig:       subnetConfigBytes,
+			Owner:              getNetworkSubnetOwner(spec.SubnetSpec.Owner),
+			ParticipantWeights: spec.SubnetSpec.ParticipantWeights,
+		}
+		if subnetSpec.Owner != nil {
+			if err := subnetSpec.Owner.Validate(); err != nil {
+				return network.BlockchainSpec{}, err
+			}
+		}
+		if err := subnetSpec.ValidateParticipantWeights(); err != nil {
+			return network.BlockchainSpec{}, err
 		}
 		blockchainSpec.SubnetSpec = &subnetSpec
 	}
@@ -1279,8 +2140,73 @@ func getNetworkSubnetSpec(
 			return network.SubnetSpec{}, err
 		}
 	}
-	return network.SubnetSpec{
-		Participants: spec.Participants,
-		SubnetConfig: subnetConfigBytes,
-	}, nil
+	owner := getNetworkSubnetOwner(spec.Owner)
+	if owner != nil {
+		if err := owner.Validate(); err != nil {
+			return network.SubnetSpec{}, err
+		}
+	}
+	subnetSpec := network.SubnetSpec{
+		Participants:       spec.Participants,
+		SubnetConfig:       subnetConfigBytes,
+		Owner:              owner,
+		ParticipantWeights: spec.ParticipantWeights,
+	}
+	if err := subnetSpec.ValidateParticipantWeights(); err != nil {
+		return network.SubnetSpec{}, err
+	}
+	return subnetSpec, nil
+}
+
+// Returns the node validator config given by [spec], which may be nil.
+func GetValidatorConfig(spec *rpcpb.ValidatorSpec) (node.ValidatorConfig, error) {
+	if spec == nil {
+		return node.ValidatorConfig{}, nil
+	}
+	validatorConfig := node.ValidatorConfig{
+		Stake:         spec.Stake,
+		DelegationFee: spec.DelegationFee,
+	}
+	if spec.Duration != "" {
+		duration, err := time.ParseDuration(spec.Duration)
+		if err != nil {
+			return node.ValidatorConfig{}, fmt.Errorf("invalid validator duration %q: %w", spec.Duration, err)
+		}
+		validatorConfig.Duration = duration
+	}
+	return validatorConfig, validatorConfig.Validate()
+}
+
+// Returns the delegation requested by [req].
+func GetDelegatorSpec(req *rpcpb.AddDelegatorRequest) (network.DelegatorSpec, error) {
+	spec := network.DelegatorSpec{
+		Amount:       req.Amount,
+		RewardsOwner: req.RewardsOwner,
+	}
+	if req.SubnetId != nil {
+		subnetID, err := ids.FromString(*req.SubnetId)
+		if err != nil {
+			return network.DelegatorSpec{}, fmt.Errorf("invalid delegation subnet ID %q: %w", *req.SubnetId, err)
+		}
+		spec.SubnetID = subnetID
+	}
+	if req.Duration != "" {
+		duration, err := time.ParseDuration(req.Duration)
+		if err != nil {
+			return network.DelegatorSpec{}, fmt.Errorf("invalid delegation duration %q: %w", req.Duration, err)
+		}
+		spec.Duration = duration
+	}
+	return spec, nil
+}
+
+func getNetworkSubnetOwner(owner *rpcpb.SubnetOwner) *network.SubnetOwner {
+	if owner == nil {
+		return nil
+	}
+	return &network.SubnetOwner{
+		Threshold: owner.Threshold,
+		Keys:      owner.Keys,
+		Addresses: owner.Addresses,
+	}
 }
@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package servertest provides utilities for testing against a network runner.
+package servertest
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/client"
+	"github.com/ava-labs/avalanche-network-runner/server"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+const (
+	// EndpointEnvVar, when set, makes [New] connect to a running server
+	// at that endpoint instead of creating an embedded runner.
+	EndpointEnvVar = "ANR_TEST_ENDPOINT"
+
+	cleanupTimeout = time.Minute
+	dialTimeout    = 10 * time.Second
+	// number of trailing lines of each node log dumped on failure
+	logTailLines = 100
+	nodeLogFile  = "main.log"
+)
+
+type Config struct {
+	// Endpoint of a running network runner server.
+	// If empty, [EndpointEnvVar] is checked, and if that is
+	// empty as well an embedded runner is used.
+	Endpoint string
+	// Server config for the embedded runner.
+	Server server.Config
+	// Defaults to no logging.
+	Log logging.Logger
+}
+
+// New returns a client for use in [t], backed either by a remote server or
+// by an embedded in-process runner, so the same test code runs against both.
+// On cleanup, if [t] failed, the tail of each node log is written to the test
+// log. The network is then stopped and the client closed.
+func New(t testing.TB, cfg Config) client.Client {
+	t.Helper()
+
+	log := cfg.Log
+	if log == nil {
+		log = logging.NoLog{}
+	}
+
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = os.Getenv(EndpointEnvVar)
+	}
+
+	var cli client.Client
+	if endpoint == "" {
+		cli = server.NewEmbedded(cfg.Server, log)
+	} else {
+		var err error
+		cli, err = client.New(client.Config{
+			Endpoint:    endpoint,
+			DialTimeout: dialTimeout,
+		}, log)
+		if err != nil {
+			t.Fatalf("failed to connect to %q: %s", endpoint, err)
+		}
+	}
+
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+		defer cancel()
+		if t.Failed() {
+			DumpLogs(ctx, t, cli)
+		}
+		if _, err := cli.Stop(ctx); err != nil && !server.IsServerError(err, server.ErrNotBootstrapped) {
+			t.Logf("failed to stop network: %s", err)
+		}
+		if err := cli.Close(); err != nil {
+			t.Logf("failed to close client: %s", err)
+		}
+	})
+	return cli
+}
+
+// DumpLogs writes the tail of the log of each node of the network
+// managed by [cli] to the test log.
+// Logs of a remote server are only found if it shares the filesystem.
+func DumpLogs(ctx context.Context, t testing.TB, cli client.Client) {
+	t.Helper()
+
+	resp, err := cli.Status(ctx)
+	if err != nil {
+		t.Logf("failed to get cluster info for log dump: %s", err)
+		return
+	}
+	nodeInfos := resp.GetClusterInfo().GetNodeInfos()
+	nodeNames := make([]string, 0, len(nodeInfos))
+	for nodeName := range nodeInfos {
+		nodeNames = append(nodeNames, nodeName)
+	}
+	sort.Strings(nodeNames)
+	for _, nodeName := range nodeNames {
+		logPath := filepath.Join(nodeInfos[nodeName].GetLogDir(), nodeLogFile)
+		lines, err := tail(logPath, logTailLines)
+		if err != nil {
+			t.Logf("failed to read log of node %s: %s", nodeName, err)
+			continue
+		}
+		t.Logf("==== last %d lines of %s ====", len(lines), logPath)
+		for _, line := range lines {
+			t.Logf("[%s] %s", nodeName, line)
+		}
+	}
+}
+
+// Returns the last [n] lines of the file at [path].
+func tail(path string, n int) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	lines := []string{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+		if len(lines) > n {
+			lines = lines[1:]
+		}
+	}
+	return lines, scanner.Err()
+}
@@ -1,11 +1,23 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"math"
 
+	"github.com/ava-labs/avalanche-network-runner/network"
 	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"github.com/ava-labs/avalanche-network-runner/utils/fastcopy"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 )
 
+// Returns a context bounded by [apiCallTimeout], for a single node API call.
+func createDefaultCtx(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, apiCallTimeout)
+}
+
 func deepCopy(i *rpcpb.ClusterInfo) (*rpcpb.ClusterInfo, error) {
 	b, err := json.Marshal(i)
 	if err != nil {
@@ -17,3 +29,63 @@ func deepCopy(i *rpcpb.ClusterInfo) (*rpcpb.ClusterInfo, error) {
 	}
 	return &clusterInfo, nil
 }
+
+// Converts the port range of a request, zero values meaning the default range.
+func getPortRange(start uint32, end uint32) (network.PortRange, error) {
+	if start > math.MaxUint16 || end > math.MaxUint16 {
+		return network.PortRange{}, fmt.Errorf("%w: [%d, %d]", ErrInvalidPortRange, start, end)
+	}
+	portRange := network.PortRange{Start: uint16(start), End: uint16(end)}
+	if err := portRange.Validate(); err != nil {
+		return network.PortRange{}, fmt.Errorf("%w: %s", ErrInvalidPortRange, err)
+	}
+	return portRange, nil
+}
+
+// Parses the IDs of a request.
+func ParseIDs(idStrs []string) ([]ids.ID, error) {
+	parsed := make([]ids.ID, len(idStrs))
+	for i, idStr := range idStrs {
+		id, err := ids.FromString(idStr)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse ID %q: %w", idStr, err)
+		}
+		parsed[i] = id
+	}
+	return parsed, nil
+}
+
+func ToRPCDepositOffers(offers []*deposit.Offer) []*rpcpb.DepositOffer {
+	rpcOffers := make([]*rpcpb.DepositOffer, len(offers))
+	for i, offer := range offers {
+		rpcOffers[i] = &rpcpb.DepositOffer{
+			Id:                      offer.ID.String(),
+			InterestRateNominator:   offer.InterestRateNominator,
+			Start:                   offer.Start,
+			End:                     offer.End,
+			MinAmount:               offer.MinAmount,
+			TotalMaxAmount:          offer.TotalMaxAmount,
+			DepositedAmount:         offer.DepositedAmount,
+			MinDuration:             offer.MinDuration,
+			MaxDuration:             offer.MaxDuration,
+			UnlockPeriodDuration:    offer.UnlockPeriodDuration,
+			NoRewardsPeriodDuration: offer.NoRewardsPeriodDuration,
+			Memo:                    string(offer.Memo),
+			Flags:                   offer.Flags,
+		}
+	}
+	return rpcOffers
+}
+
+func toRPCDBCopies(dbCopies map[string]fastcopy.Result) map[string]*rpcpb.DBCopy {
+	rpcDBCopies := make(map[string]*rpcpb.DBCopy, len(dbCopies))
+	for nodeName, result := range dbCopies {
+		rpcDBCopies[nodeName] = &rpcpb.DBCopy{
+			Strategy:   string(result.Strategy()),
+			Reflinked:  uint32(result.Reflinked),
+			Hardlinked: uint32(result.Hardlinked),
+			Copied:     uint32(result.Copied),
+		}
+	}
+	return rpcDBCopies
+}
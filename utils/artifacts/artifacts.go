@@ -0,0 +1,249 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package artifacts implements the area where clients upload the files a
+// runner server needs, such as node binaries, plugin dirs, genesis and config
+// files. An artifact is stored by the sha256 of its uploaded contents, and
+// referred to in requests as [RefPrefix] followed by that hash.
+// Dirs are uploaded as tar archives, and extracted on the server.
+package artifacts
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/user"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// RefPrefix prefixes the references to artifacts given in place of paths.
+const RefPrefix = "artifact:"
+
+var (
+	ErrArtifactNotFound = errors.New("artifact not found")
+	ErrInvalidRef       = errors.New("invalid artifact reference")
+	ErrInvalidArchive   = errors.New("invalid dir archive")
+
+	idRe = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+	// store dir used if none is given
+	defaultDir string
+)
+
+func init() {
+	usr, err := user.Current()
+	if err != nil {
+		panic(err)
+	}
+	defaultDir = filepath.Join(usr.HomeDir, ".camino-network-runner", "artifacts")
+}
+
+// Ref returns the reference to the artifact [id].
+func Ref(id string) string {
+	return RefPrefix + id
+}
+
+// IsRef returns true if [s] is a reference to an artifact.
+func IsRef(s string) bool {
+	return strings.HasPrefix(s, RefPrefix)
+}
+
+// Store holds the uploaded artifacts in a dir, at a path named by their id.
+// It can be shared by several processes.
+type Store struct {
+	dir string
+}
+
+// New returns the store at [dir], or at ~/.camino-network-runner/artifacts if
+// [dir] is empty. The dir is created on the first upload.
+func New(dir string) *Store {
+	if dir == "" {
+		dir = defaultDir
+	}
+	return &Store{dir: dir}
+}
+
+// Add stores the contents read from [r], and returns their id and size.
+// If [isDir] is true, the contents must be a tar archive written by
+// [WriteDirArchive], and are extracted. Files are stored as executables,
+// as they are usually binaries or plugins.
+// Adding existing contents again is a no-op.
+func (s *Store) Add(r io.Reader, isDir bool) (string, int64, error) {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return "", 0, err
+	}
+	tmpFile, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return "", 0, err
+	}
+	defer os.Remove(tmpFile.Name())
+	h := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmpFile, h), r)
+	if err != nil {
+		_ = tmpFile.Close()
+		return "", 0, err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return "", 0, err
+	}
+	id := hex.EncodeToString(h.Sum(nil))
+	artifactPath := filepath.Join(s.dir, id)
+	if _, err := os.Stat(artifactPath); err == nil {
+		return id, size, nil
+	}
+
+	if !isDir {
+		if err := os.Chmod(tmpFile.Name(), 0o755); err != nil {
+			return "", 0, err
+		}
+		return id, size, os.Rename(tmpFile.Name(), artifactPath)
+	}
+	tmpDir, err := os.MkdirTemp(s.dir, ".extract-*")
+	if err != nil {
+		return "", 0, err
+	}
+	defer os.RemoveAll(tmpDir)
+	if err := extractDirArchive(tmpFile.Name(), tmpDir); err != nil {
+		return "", 0, err
+	}
+	if err := os.Rename(tmpDir, artifactPath); err != nil {
+		// a concurrent upload of the same contents won
+		if _, statErr := os.Stat(artifactPath); statErr == nil {
+			return id, size, nil
+		}
+		return "", 0, err
+	}
+	return id, size, nil
+}
+
+// Path returns the path of the artifact [id].
+func (s *Store) Path(id string) (string, error) {
+	if !idRe.MatchString(id) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidRef, Ref(id))
+	}
+	artifactPath := filepath.Join(s.dir, id)
+	if _, err := os.Stat(artifactPath); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("%w: %s", ErrArtifactNotFound, id)
+		}
+		return "", err
+	}
+	return artifactPath, nil
+}
+
+// Resolve returns the path of the referenced artifact if [pathOrRef] is an
+// artifact reference, or [pathOrRef] as is otherwise.
+func (s *Store) Resolve(pathOrRef string) (string, error) {
+	if !IsRef(pathOrRef) {
+		return pathOrRef, nil
+	}
+	return s.Path(strings.TrimPrefix(pathOrRef, RefPrefix))
+}
+
+// WriteDirArchive writes the tar archive of the dir at [dir] to [w].
+// Only dirs and regular files are archived, with their permissions, so that
+// the archive of identical trees is the same.
+func WriteDirArchive(w io.Writer, dir string) error {
+	tw := tar.NewWriter(w)
+	err := filepath.WalkDir(dir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(dir, filePath)
+		if err != nil || relPath == "." {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		hdr := &tar.Header{
+			Name: filepath.ToSlash(relPath),
+			Mode: int64(info.Mode().Perm()),
+		}
+		switch {
+		case d.IsDir():
+			hdr.Typeflag = tar.TypeDir
+			hdr.Name += "/"
+			return tw.WriteHeader(hdr)
+		case d.Type().IsRegular():
+			hdr.Typeflag = tar.TypeReg
+			hdr.Size = info.Size()
+			if err := tw.WriteHeader(hdr); err != nil {
+				return err
+			}
+			f, err := os.Open(filePath)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			_, err = io.Copy(tw, f)
+			return err
+		}
+		return fmt.Errorf("unsupported file type at %q: %s", filePath, d.Type())
+	})
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+// Extracts the tar archive at [archivePath] to the existing dir [dir].
+func extractDirArchive(archivePath string, dir string) error {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	tr := tar.NewReader(f)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidArchive, err)
+		}
+		name := path.Clean(hdr.Name)
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return fmt.Errorf("%w: path %q", ErrInvalidArchive, hdr.Name)
+		}
+		targetPath := filepath.Join(dir, filepath.FromSlash(name))
+		perm := fs.FileMode(hdr.Mode).Perm()
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(targetPath, perm|0o700); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(targetPath), 0o755); err != nil {
+				return err
+			}
+			if err := extractFile(tr, targetPath, perm); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("%w: unsupported type of %q", ErrInvalidArchive, hdr.Name)
+		}
+	}
+}
+
+func extractFile(r io.Reader, filePath string, perm fs.FileMode) error {
+	out, err := os.OpenFile(filePath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, r); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package artifacts
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddFile(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s := New(filepath.Join(t.TempDir(), "artifacts"))
+	contents := []byte("genesis")
+	id, size, err := s.Add(bytes.NewReader(contents), false)
+	require.NoError(err)
+	hash := sha256.Sum256(contents)
+	require.Equal(hex.EncodeToString(hash[:]), id)
+	require.Equal(int64(len(contents)), size)
+
+	artifactPath, err := s.Resolve(Ref(id))
+	require.NoError(err)
+	stored, err := os.ReadFile(artifactPath)
+	require.NoError(err)
+	require.Equal(contents, stored)
+	info, err := os.Stat(artifactPath)
+	require.NoError(err)
+	require.Equal(os.FileMode(0o755), info.Mode().Perm())
+
+	// adding again is a no-op
+	id2, _, err := s.Add(bytes.NewReader(contents), false)
+	require.NoError(err)
+	require.Equal(id, id2)
+	// only the artifact is left in the store
+	entries, err := os.ReadDir(filepath.Dir(artifactPath))
+	require.NoError(err)
+	require.Len(entries, 1)
+}
+
+func TestAddDir(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	dir := t.TempDir()
+	require.NoError(os.MkdirAll(filepath.Join(dir, "sub"), 0o755))
+	require.NoError(os.WriteFile(filepath.Join(dir, "vm"), []byte("vm"), 0o755))
+	require.NoError(os.WriteFile(filepath.Join(dir, "sub", "config.json"), []byte("{}"), 0o644))
+
+	archive := bytes.Buffer{}
+	require.NoError(WriteDirArchive(&archive, dir))
+	// archives of identical trees are the same
+	archive2 := bytes.Buffer{}
+	require.NoError(WriteDirArchive(&archive2, dir))
+	require.Equal(archive.Bytes(), archive2.Bytes())
+
+	s := New(t.TempDir())
+	id, _, err := s.Add(&archive, true)
+	require.NoError(err)
+	artifactPath, err := s.Path(id)
+	require.NoError(err)
+	vm, err := os.ReadFile(filepath.Join(artifactPath, "vm"))
+	require.NoError(err)
+	require.Equal([]byte("vm"), vm)
+	info, err := os.Stat(filepath.Join(artifactPath, "sub", "config.json"))
+	require.NoError(err)
+	require.Equal(os.FileMode(0o644), info.Mode().Perm())
+}
+
+func TestAddInvalidArchive(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	archive := bytes.Buffer{}
+	tw := tar.NewWriter(&archive)
+	require.NoError(tw.WriteHeader(&tar.Header{
+		Name:     "../escape",
+		Typeflag: tar.TypeReg,
+		Mode:     0o644,
+		Size:     1,
+	}))
+	_, err := tw.Write([]byte("x"))
+	require.NoError(err)
+	require.NoError(tw.Close())
+
+	s := New(t.TempDir())
+	_, _, err = s.Add(&archive, true)
+	require.ErrorIs(err, ErrInvalidArchive)
+	_, _, err = s.Add(strings.NewReader("not a tar archive"), true)
+	require.ErrorIs(err, ErrInvalidArchive)
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s := New(t.TempDir())
+	// paths are kept
+	for _, path := range []string{"", "/tmp/genesis.json", "camino-node"} {
+		resolved, err := s.Resolve(path)
+		require.NoError(err)
+		require.Equal(path, resolved)
+	}
+	_, err := s.Resolve(Ref("../genesis.json"))
+	require.ErrorIs(err, ErrInvalidRef)
+	_, err = s.Resolve(Ref(strings.Repeat("0", 64)))
+	require.ErrorIs(err, ErrArtifactNotFound)
+}
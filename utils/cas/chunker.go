@@ -0,0 +1,86 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package cas
+
+import (
+	"errors"
+	"io"
+)
+
+const (
+	minChunkSize = 64 * 1024
+	maxChunkSize = 2 * 1024 * 1024
+	// a cut point every 512 KiB on average, past the minimum size
+	chunkMask = 1<<19 - 1
+)
+
+// Random values indexed by byte, for the rolling gear hash.
+var gear [256]uint64
+
+func init() {
+	// fixed seed, cut points must be the same across runs to share chunks
+	seed := uint64(0x9e3779b97f4a7c15)
+	for i := range gear {
+		// splitmix64
+		seed += 0x9e3779b97f4a7c15
+		z := seed
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		gear[i] = z ^ (z >> 31)
+	}
+}
+
+// chunker splits a stream in content defined chunks, so that an insertion or
+// deletion in a file only changes the chunks around it.
+type chunker struct {
+	r   io.Reader
+	buf []byte
+	// number of buffered bytes
+	n   int
+	eof bool
+}
+
+func newChunker(r io.Reader) *chunker {
+	return &chunker{
+		r:   r,
+		buf: make([]byte, maxChunkSize),
+	}
+}
+
+// next returns the next chunk, or io.EOF after the last one.
+func (c *chunker) next() ([]byte, error) {
+	for c.n < len(c.buf) && !c.eof {
+		read, err := c.r.Read(c.buf[c.n:])
+		c.n += read
+		switch {
+		case errors.Is(err, io.EOF):
+			c.eof = true
+		case err != nil:
+			return nil, err
+		}
+	}
+	if c.n == 0 {
+		return nil, io.EOF
+	}
+	size := cutPoint(c.buf[:c.n])
+	chunk := make([]byte, size)
+	copy(chunk, c.buf[:size])
+	c.n = copy(c.buf, c.buf[size:c.n])
+	return chunk, nil
+}
+
+// Returns the size of the first chunk of [data].
+func cutPoint(data []byte) int {
+	if len(data) <= minChunkSize {
+		return len(data)
+	}
+	var hash uint64
+	for i := minChunkSize; i < len(data); i++ {
+		hash = (hash << 1) + gear[data[i]]
+		if hash&chunkMask == 0 {
+			return i + 1
+		}
+	}
+	return len(data)
+}
@@ -0,0 +1,394 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package cas implements a content addressed store for directory trees.
+// Files are split in content defined chunks, stored once by hash and
+// compressed with zstd, so trees with similar contents, such as the databases
+// of nodes on the same network, share most of their storage.
+// A tree is described by a manifest, and chunks not referenced by any
+// manifest are removed by the garbage collector.
+package cas
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/klauspost/compress/zstd"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	chunksDirName = "chunks"
+	lockFileName  = "store.lock"
+	manifestPerms = 0o644
+	chunkPerms    = 0o444
+)
+
+var (
+	ErrCorruptChunk = errors.New("corrupt chunk")
+	ErrInvalidEntry = errors.New("invalid manifest entry")
+)
+
+// Store holds the chunks of the trees saved to it.
+// It can be shared by several processes.
+type Store struct {
+	dir     string
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+// Manifest describes the trees saved by a Store.Save call.
+type Manifest struct {
+	Entries []Entry `json:"entries"`
+}
+
+// Entry is a directory, symlink or regular file of a manifest.
+type Entry struct {
+	// Slash separated, prefixed by the name of the saved dir
+	Path string      `json:"path"`
+	Mode fs.FileMode `json:"mode"`
+	// Symlink target
+	Target string `json:"target,omitempty"`
+	// Regular file size and contents
+	Size   int64    `json:"size,omitempty"`
+	Chunks []string `json:"chunks,omitempty"`
+}
+
+// Stats of a Store.Save call.
+type Stats struct {
+	// Chunks and uncompressed bytes of the saved files
+	Chunks int
+	Bytes  int64
+	// Chunks not already in the store, and their compressed bytes
+	NewChunks      int
+	NewStoredBytes int64
+}
+
+// Open returns the store at [dir], creating it if needed.
+// It must be closed after use.
+func Open(dir string) (*Store, error) {
+	if err := os.MkdirAll(filepath.Join(dir, chunksDirName), 0o755); err != nil {
+		return nil, err
+	}
+	encoder, err := zstd.NewWriter(nil)
+	if err != nil {
+		return nil, err
+	}
+	decoder, err := zstd.NewReader(nil)
+	if err != nil {
+		_ = encoder.Close()
+		return nil, err
+	}
+	return &Store{
+		dir:     dir,
+		encoder: encoder,
+		decoder: decoder,
+	}, nil
+}
+
+// Close releases the resources of the store.
+func (s *Store) Close() error {
+	s.decoder.Close()
+	return s.encoder.Close()
+}
+
+// Save stores the trees at the values of [dirs], and writes a manifest of them
+// to [manifestPath]. Entries of each tree are prefixed with its key in [dirs].
+// The chunks can't be collected before the manifest is written.
+func (s *Store) Save(manifestPath string, dirs map[string]string) (Stats, error) {
+	unlock, err := s.lock(syscall.LOCK_SH)
+	if err != nil {
+		return Stats{}, err
+	}
+	defer unlock()
+
+	stats := Stats{}
+	manifest := Manifest{}
+	names := maps.Keys(dirs)
+	sort.Strings(names)
+	for _, name := range names {
+		dir := dirs[name]
+		if err := s.saveDir(&manifest, &stats, name, dir); err != nil {
+			return stats, fmt.Errorf("failure saving %q: %w", dir, err)
+		}
+	}
+	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return stats, err
+	}
+	tmpPath := manifestPath + ".tmp"
+	if err := os.WriteFile(tmpPath, manifestBytes, manifestPerms); err != nil {
+		return stats, err
+	}
+	return stats, os.Rename(tmpPath, manifestPath)
+}
+
+func (s *Store) saveDir(manifest *Manifest, stats *Stats, name string, dir string) error {
+	return filepath.WalkDir(dir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(dir, filePath)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		entry := Entry{
+			Path: path.Join(name, filepath.ToSlash(relPath)),
+			Mode: info.Mode(),
+		}
+		switch {
+		case d.IsDir():
+		case d.Type()&fs.ModeSymlink != 0:
+			entry.Target, err = os.Readlink(filePath)
+			if err != nil {
+				return err
+			}
+		case d.Type().IsRegular():
+			entry.Size = info.Size()
+			entry.Chunks, err = s.saveFile(stats, filePath)
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported file type at %q: %s", filePath, d.Type())
+		}
+		manifest.Entries = append(manifest.Entries, entry)
+		return nil
+	})
+}
+
+// Stores the chunks of the file at [filePath] and returns their ids.
+func (s *Store) saveFile(stats *Stats, filePath string) ([]string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	chunks := []string{}
+	c := newChunker(f)
+	for {
+		chunk, err := c.next()
+		if errors.Is(err, io.EOF) {
+			return chunks, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		id, err := s.putChunk(stats, chunk)
+		if err != nil {
+			return nil, err
+		}
+		chunks = append(chunks, id)
+	}
+}
+
+func (s *Store) putChunk(stats *Stats, chunk []byte) (string, error) {
+	hash := sha256.Sum256(chunk)
+	id := hex.EncodeToString(hash[:])
+	stats.Chunks++
+	stats.Bytes += int64(len(chunk))
+	chunkPath := s.chunkPath(id)
+	if _, err := os.Stat(chunkPath); err == nil {
+		return id, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(chunkPath), 0o755); err != nil {
+		return "", err
+	}
+	compressed := s.encoder.EncodeAll(chunk, nil)
+	// concurrent saves of the same chunk write the same contents
+	tmpFile, err := os.CreateTemp(filepath.Dir(chunkPath), id+".tmp*")
+	if err != nil {
+		return "", err
+	}
+	if _, err := tmpFile.Write(compressed); err != nil {
+		_ = tmpFile.Close()
+		_ = os.Remove(tmpFile.Name())
+		return "", err
+	}
+	if err := tmpFile.Close(); err != nil {
+		_ = os.Remove(tmpFile.Name())
+		return "", err
+	}
+	if err := os.Chmod(tmpFile.Name(), chunkPerms); err != nil {
+		_ = os.Remove(tmpFile.Name())
+		return "", err
+	}
+	if err := os.Rename(tmpFile.Name(), chunkPath); err != nil {
+		_ = os.Remove(tmpFile.Name())
+		return "", err
+	}
+	stats.NewChunks++
+	stats.NewStoredBytes += int64(len(compressed))
+	return id, nil
+}
+
+// Load restores the trees of the manifest at [manifestPath] to the values of
+// [dirs], keyed by the names given to Save. Files must not exist in the dirs.
+func (s *Store) Load(manifestPath string, dirs map[string]string) error {
+	manifest, err := ReadManifest(manifestPath)
+	if err != nil {
+		return err
+	}
+	for _, entry := range manifest.Entries {
+		if !fs.ValidPath(entry.Path) {
+			return fmt.Errorf("%w: path %q", ErrInvalidEntry, entry.Path)
+		}
+		name, relPath, _ := strings.Cut(entry.Path, "/")
+		dir, ok := dirs[name]
+		if !ok {
+			continue
+		}
+		if err := s.loadEntry(entry, filepath.Join(dir, filepath.FromSlash(relPath))); err != nil {
+			return fmt.Errorf("failure loading %q: %w", entry.Path, err)
+		}
+	}
+	return nil
+}
+
+func (s *Store) loadEntry(entry Entry, dst string) error {
+	switch {
+	case entry.Mode.IsDir():
+		return os.MkdirAll(dst, entry.Mode.Perm())
+	case entry.Mode&fs.ModeSymlink != 0:
+		return os.Symlink(entry.Target, dst)
+	case entry.Mode.IsRegular():
+		return s.loadFile(entry, dst)
+	}
+	return fmt.Errorf("%w: mode %s", ErrInvalidEntry, entry.Mode)
+}
+
+func (s *Store) loadFile(entry Entry, dst string) error {
+	f, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, entry.Mode.Perm())
+	if err != nil {
+		return err
+	}
+	size := int64(0)
+	for _, id := range entry.Chunks {
+		chunk, err := s.getChunk(id)
+		if err != nil {
+			_ = f.Close()
+			return err
+		}
+		if _, err := f.Write(chunk); err != nil {
+			_ = f.Close()
+			return err
+		}
+		size += int64(len(chunk))
+	}
+	if size != entry.Size {
+		_ = f.Close()
+		return fmt.Errorf("%w: expected size %d, got %d", ErrInvalidEntry, entry.Size, size)
+	}
+	return f.Close()
+}
+
+func (s *Store) getChunk(id string) ([]byte, error) {
+	if len(id) != 2*sha256.Size {
+		return nil, fmt.Errorf("%w: chunk id %q", ErrInvalidEntry, id)
+	}
+	compressed, err := os.ReadFile(s.chunkPath(id))
+	if err != nil {
+		return nil, err
+	}
+	chunk, err := s.decoder.DecodeAll(compressed, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w %s: %s", ErrCorruptChunk, id, err)
+	}
+	hash := sha256.Sum256(chunk)
+	if hex.EncodeToString(hash[:]) != id {
+		return nil, fmt.Errorf("%w %s: hash mismatch", ErrCorruptChunk, id)
+	}
+	return chunk, nil
+}
+
+// GC removes the chunks not referenced by the manifests at [manifestPaths],
+// which must be all the manifests using this store, and returns the number
+// of chunks removed.
+func (s *Store) GC(manifestPaths []string) (int, error) {
+	unlock, err := s.lock(syscall.LOCK_EX)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	referenced := map[string]struct{}{}
+	for _, manifestPath := range manifestPaths {
+		manifest, err := ReadManifest(manifestPath)
+		if err != nil {
+			return 0, err
+		}
+		for _, entry := range manifest.Entries {
+			for _, id := range entry.Chunks {
+				referenced[id] = struct{}{}
+			}
+		}
+	}
+	removed := 0
+	err = filepath.WalkDir(filepath.Join(s.dir, chunksDirName), func(chunkPath string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		// temporary files are left behind by interrupted saves
+		if _, ok := referenced[d.Name()]; ok {
+			return nil
+		}
+		if err := os.Remove(chunkPath); err != nil {
+			return err
+		}
+		removed++
+		return nil
+	})
+	return removed, err
+}
+
+// ReadManifest reads the manifest at [manifestPath].
+func ReadManifest(manifestPath string) (*Manifest, error) {
+	manifestBytes, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{}
+	if err := json.Unmarshal(manifestBytes, manifest); err != nil {
+		return nil, fmt.Errorf("failure unmarshaling manifest %q: %w", manifestPath, err)
+	}
+	return manifest, nil
+}
+
+// Chunks are sharded by the first byte of their id, to keep dirs small.
+func (s *Store) chunkPath(id string) string {
+	return filepath.Join(s.dir, chunksDirName, id[:2], id)
+}
+
+// Saves hold a shared lock and the garbage collector an exclusive one, so
+// that chunks of a manifest being saved are never collected.
+func (s *Store) lock(how int) (func(), error) {
+	lockFile, err := os.OpenFile(filepath.Join(s.dir, lockFileName), os.O_CREATE|os.O_RDWR, manifestPerms)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't open store lock: %w", err)
+	}
+	if err := syscall.Flock(int(lockFile.Fd()), how); err != nil {
+		_ = lockFile.Close()
+		return nil, fmt.Errorf("couldn't lock store: %w", err)
+	}
+	return func() {
+		_ = syscall.Flock(int(lockFile.Fd()), syscall.LOCK_UN)
+		_ = lockFile.Close()
+	}, nil
+}
@@ -0,0 +1,194 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package cas
+
+import (
+	"bytes"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func randomBytes(seed int64, size int) []byte {
+	b := make([]byte, size)
+	_, _ = rand.New(rand.NewSource(seed)).Read(b)
+	return b
+}
+
+func writeTestTree(t *testing.T, dir string, table []byte) {
+	require := require.New(t)
+	require.NoError(os.MkdirAll(filepath.Join(dir, "sub", "empty"), 0o755))
+	require.NoError(os.WriteFile(filepath.Join(dir, "000001.ldb"), table, 0o644))
+	require.NoError(os.WriteFile(filepath.Join(dir, "MANIFEST-000002"), []byte("manifest"), 0o600))
+	require.NoError(os.WriteFile(filepath.Join(dir, "sub", "LOG"), nil, 0o644))
+	require.NoError(os.Symlink("MANIFEST-000002", filepath.Join(dir, "CURRENT")))
+}
+
+func requireSameTree(t *testing.T, src string, dst string) {
+	require := require.New(t)
+	for _, name := range []string{"000001.ldb", "MANIFEST-000002", filepath.Join("sub", "LOG")} {
+		srcBytes, err := os.ReadFile(filepath.Join(src, name))
+		require.NoError(err)
+		dstBytes, err := os.ReadFile(filepath.Join(dst, name))
+		require.NoError(err)
+		require.True(bytes.Equal(srcBytes, dstBytes), name)
+	}
+	info, err := os.Stat(filepath.Join(dst, "MANIFEST-000002"))
+	require.NoError(err)
+	require.Equal(os.FileMode(0o600), info.Mode().Perm())
+	info, err = os.Stat(filepath.Join(dst, "sub", "empty"))
+	require.NoError(err)
+	require.True(info.IsDir())
+	target, err := os.Readlink(filepath.Join(dst, "CURRENT"))
+	require.NoError(err)
+	require.Equal("MANIFEST-000002", target)
+}
+
+func countChunks(t *testing.T, storeDir string) int {
+	matches, err := filepath.Glob(filepath.Join(storeDir, chunksDirName, "*", "*"))
+	require.NoError(t, err)
+	return len(matches)
+}
+
+func TestCutPoint(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	data := randomBytes(1, 8*maxChunkSize)
+	c := newChunker(bytes.NewReader(data))
+	sizes := []int{}
+	for {
+		chunk, err := c.next()
+		if err != nil {
+			break
+		}
+		require.LessOrEqual(len(chunk), maxChunkSize)
+		sizes = append(sizes, len(chunk))
+	}
+	total := 0
+	for i, size := range sizes {
+		if i < len(sizes)-1 {
+			require.Greater(size, minChunkSize)
+		}
+		total += size
+	}
+	require.Equal(len(data), total)
+
+	// cut points only depend on the contents around them
+	shifted := append(randomBytes(2, 1000), data...)
+	require.Equal(sizes[1], cutPoint(shifted[1000+sizes[0]:]))
+}
+
+func TestSaveLoad(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	storeDir := t.TempDir()
+	s, err := Open(storeDir)
+	require.NoError(err)
+	defer s.Close()
+
+	table := randomBytes(3, 3*maxChunkSize)
+	node1 := t.TempDir()
+	writeTestTree(t, node1, table)
+	// same table, with a few bytes prepended
+	node2 := t.TempDir()
+	writeTestTree(t, node2, append([]byte("prefix"), table...))
+
+	manifestPath := filepath.Join(t.TempDir(), "manifest.json")
+	stats, err := s.Save(manifestPath, map[string]string{"node1": node1, "node2": node2})
+	require.NoError(err)
+	require.Less(stats.NewChunks, stats.Chunks)
+	require.Equal(stats.NewChunks, countChunks(t, storeDir))
+
+	// saving the same trees again adds no chunk
+	stats, err = s.Save(filepath.Join(t.TempDir(), "manifest.json"), map[string]string{"node1": node1})
+	require.NoError(err)
+	require.Zero(stats.NewChunks)
+
+	dst := t.TempDir()
+	require.NoError(s.Load(manifestPath, map[string]string{
+		"node1": filepath.Join(dst, "node1", "db"),
+		"node2": filepath.Join(dst, "node2", "db"),
+	}))
+	requireSameTree(t, node1, filepath.Join(dst, "node1", "db"))
+	requireSameTree(t, node2, filepath.Join(dst, "node2", "db"))
+
+	// only the given trees are loaded
+	dst = t.TempDir()
+	require.NoError(s.Load(manifestPath, map[string]string{"node2": filepath.Join(dst, "db")}))
+	requireSameTree(t, node2, filepath.Join(dst, "db"))
+	_, err = os.Stat(filepath.Join(dst, "node1"))
+	require.ErrorIs(err, os.ErrNotExist)
+}
+
+func TestGC(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	storeDir := t.TempDir()
+	s, err := Open(storeDir)
+	require.NoError(err)
+	defer s.Close()
+
+	node1 := t.TempDir()
+	writeTestTree(t, node1, randomBytes(4, maxChunkSize))
+	node2 := t.TempDir()
+	writeTestTree(t, node2, randomBytes(5, maxChunkSize))
+	manifestsDir := t.TempDir()
+	manifest1 := filepath.Join(manifestsDir, "1.json")
+	manifest2 := filepath.Join(manifestsDir, "2.json")
+	_, err = s.Save(manifest1, map[string]string{"node": node1})
+	require.NoError(err)
+	_, err = s.Save(manifest2, map[string]string{"node": node2})
+	require.NoError(err)
+	numChunks := countChunks(t, storeDir)
+
+	removed, err := s.GC([]string{manifest1, manifest2})
+	require.NoError(err)
+	require.Zero(removed)
+
+	removed, err = s.GC([]string{manifest2})
+	require.NoError(err)
+	require.Positive(removed)
+	require.Equal(numChunks-removed, countChunks(t, storeDir))
+	dst := t.TempDir()
+	require.NoError(s.Load(manifest2, map[string]string{"node": filepath.Join(dst, "db")}))
+	requireSameTree(t, node2, filepath.Join(dst, "db"))
+
+	_, err = s.GC(nil)
+	require.NoError(err)
+	require.Zero(countChunks(t, storeDir))
+	require.ErrorIs(s.Load(manifest2, map[string]string{"node": filepath.Join(t.TempDir(), "db")}), os.ErrNotExist)
+}
+
+func TestLoadCorruptChunk(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	storeDir := t.TempDir()
+	s, err := Open(storeDir)
+	require.NoError(err)
+	defer s.Close()
+
+	src := t.TempDir()
+	writeTestTree(t, src, []byte("table"))
+	manifestPath := filepath.Join(t.TempDir(), "manifest.json")
+	_, err = s.Save(manifestPath, map[string]string{"node": src})
+	require.NoError(err)
+	manifest, err := ReadManifest(manifestPath)
+	require.NoError(err)
+	for _, entry := range manifest.Entries {
+		for _, id := range entry.Chunks {
+			chunkPath := s.chunkPath(id)
+			require.NoError(os.Chmod(chunkPath, 0o644))
+			require.NoError(os.WriteFile(chunkPath, s.encoder.EncodeAll([]byte("other"), nil), 0o644))
+		}
+	}
+	err = s.Load(manifestPath, map[string]string{"node": filepath.Join(t.TempDir(), "db")})
+	require.ErrorIs(err, ErrCorruptChunk)
+}
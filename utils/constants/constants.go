@@ -13,4 +13,6 @@ const (
 var (
 	LocalConfigDir   = filepath.Join("local", "default")
 	LocalGenesisFile = "genesis.json"
+	// Version of the runner, set at build time
+	Version = ""
 )
@@ -0,0 +1,160 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package fastcopy copies directory trees such as node databases, sharing
+// file contents with the source instead of duplicating them where possible.
+package fastcopy
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Strategy is the way file contents were copied.
+type Strategy string
+
+const (
+	// Files share their data extents through filesystem reflinks (btrfs, xfs).
+	Reflink Strategy = "reflink"
+	// Immutable files are hardlinked, the others are copied.
+	Hardlink Strategy = "hardlink"
+	// All files are copied.
+	Copy Strategy = "copy"
+	// Files were copied with more than one strategy, as reflinks or
+	// hardlinks failed for some of them.
+	Mixed Strategy = "mixed"
+)
+
+// Result is the number of files copied by each strategy.
+type Result struct {
+	Reflinked  int
+	Hardlinked int
+	Copied     int
+	// Immutable files copied as hardlinking them failed, included in Copied.
+	HardlinkFallbacks int
+}
+
+// Strategy returns the strategy all files were copied with, Mixed if they
+// were copied with more than one.
+func (r Result) Strategy() Strategy {
+	switch {
+	case r.Reflinked > 0 && r.Hardlinked == 0 && r.Copied == 0:
+		return Reflink
+	case r.Hardlinked > 0 && r.Reflinked == 0 && r.HardlinkFallbacks == 0:
+		// the other files of a hardlinked tree are always copied
+		return Hardlink
+	case r.Reflinked == 0 && r.Hardlinked == 0:
+		return Copy
+	}
+	return Mixed
+}
+
+// IsImmutableDBFile returns true for the table files of leveldb and pebble
+// databases, which are never modified once written, only deleted.
+func IsImmutableDBFile(path string) bool {
+	switch filepath.Ext(path) {
+	case ".ldb", ".sst":
+		return true
+	}
+	return false
+}
+
+type copier struct {
+	// returns true if the file at a path can be hardlinked, nil to never hardlink
+	immutable func(string) bool
+	// set once a reflink or hardlink fails, to not try them again
+	noReflinks  bool
+	noHardlinks bool
+	// number of files copied by each strategy
+	result Result
+}
+
+// Dir copies the directory tree at [src] to [dst], creating the missing
+// directories, and returns the number of files copied by each strategy.
+// Files must not exist in [dst]. Regular files are reflinked if the filesystem
+// supports it. Otherwise, files for which [immutable] returns true are
+// hardlinked, if [immutable] is not nil and [src] and [dst] are on the same
+// filesystem, and the other files are copied.
+// Hardlinked files must never be modified in place, in [src] or [dst].
+func Dir(src string, dst string, immutable func(string) bool) (Result, error) {
+	c := &copier{
+		immutable:   immutable,
+		noHardlinks: immutable == nil,
+	}
+	err := filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		dstPath := filepath.Join(dst, relPath)
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		switch {
+		case d.IsDir():
+			return os.MkdirAll(dstPath, info.Mode().Perm())
+		case d.Type()&fs.ModeSymlink != 0:
+			target, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(target, dstPath)
+		case d.Type().IsRegular():
+			return c.copyFile(path, dstPath, info.Mode().Perm())
+		}
+		return fmt.Errorf("unsupported file type at %q: %s", path, d.Type())
+	})
+	return c.result, err
+}
+
+func (c *copier) copyFile(src string, dst string, perm fs.FileMode) error {
+	if !c.noReflinks {
+		err := reflink(src, dst, perm)
+		if err == nil {
+			c.result.Reflinked++
+			return nil
+		}
+		// the first failure tells the filesystems don't support it
+		c.noReflinks = true
+	}
+	if c.immutable != nil && c.immutable(src) {
+		if !c.noHardlinks {
+			if err := os.Link(src, dst); err == nil {
+				c.result.Hardlinked++
+				return nil
+			}
+			// most probably different filesystems
+			c.noHardlinks = true
+		}
+		c.result.HardlinkFallbacks++
+	}
+	if err := copyContents(src, dst, perm); err != nil {
+		return err
+	}
+	c.result.Copied++
+	return nil
+}
+
+func copyContents(src string, dst string, perm fs.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fastcopy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestTree(t *testing.T, dir string) {
+	require := require.New(t)
+	require.NoError(os.MkdirAll(filepath.Join(dir, "sub"), 0o755))
+	require.NoError(os.WriteFile(filepath.Join(dir, "000001.ldb"), []byte("table"), 0o644))
+	require.NoError(os.WriteFile(filepath.Join(dir, "MANIFEST-000002"), []byte("manifest"), 0o644))
+	require.NoError(os.WriteFile(filepath.Join(dir, "sub", "000003.sst"), []byte("sst"), 0o600))
+	require.NoError(os.Symlink("MANIFEST-000002", filepath.Join(dir, "CURRENT")))
+}
+
+func requireSameTree(t *testing.T, src string, dst string) {
+	require := require.New(t)
+	for _, name := range []string{"000001.ldb", "MANIFEST-000002", filepath.Join("sub", "000003.sst")} {
+		srcBytes, err := os.ReadFile(filepath.Join(src, name))
+		require.NoError(err)
+		dstBytes, err := os.ReadFile(filepath.Join(dst, name))
+		require.NoError(err)
+		require.Equal(srcBytes, dstBytes)
+	}
+	info, err := os.Stat(filepath.Join(dst, "sub", "000003.sst"))
+	require.NoError(err)
+	require.Equal(os.FileMode(0o600), info.Mode().Perm())
+	target, err := os.Readlink(filepath.Join(dst, "CURRENT"))
+	require.NoError(err)
+	require.Equal("MANIFEST-000002", target)
+}
+
+func sameFile(t *testing.T, path1 string, path2 string) bool {
+	info1, err := os.Stat(path1)
+	require.NoError(t, err)
+	info2, err := os.Stat(path2)
+	require.NoError(t, err)
+	return os.SameFile(info1, info2)
+}
+
+func TestDir(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	src := t.TempDir()
+	writeTestTree(t, src)
+	dst := filepath.Join(t.TempDir(), "a", "b")
+	result, err := Dir(src, dst, IsImmutableDBFile)
+	require.NoError(err)
+	requireSameTree(t, src, dst)
+	require.Equal(3, result.Reflinked+result.Hardlinked+result.Copied)
+
+	// mutable files never share their inode
+	require.False(sameFile(t, filepath.Join(src, "MANIFEST-000002"), filepath.Join(dst, "MANIFEST-000002")))
+	switch strategy := result.Strategy(); strategy {
+	case Reflink:
+		require.False(sameFile(t, filepath.Join(src, "000001.ldb"), filepath.Join(dst, "000001.ldb")))
+	case Hardlink:
+		require.True(sameFile(t, filepath.Join(src, "000001.ldb"), filepath.Join(dst, "000001.ldb")))
+	default:
+		require.FailNow("same filesystem copy should share contents", "strategy %q", strategy)
+	}
+
+	// a file of the source is never modified by writes to the copy
+	require.NoError(os.WriteFile(filepath.Join(dst, "MANIFEST-000002"), []byte("changed"), 0o644))
+	srcBytes, err := os.ReadFile(filepath.Join(src, "MANIFEST-000002"))
+	require.NoError(err)
+	require.Equal([]byte("manifest"), srcBytes)
+}
+
+func TestDirNoHardlinks(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	src := t.TempDir()
+	writeTestTree(t, src)
+	dst := filepath.Join(t.TempDir(), "db")
+	result, err := Dir(src, dst, nil)
+	require.NoError(err)
+	require.Zero(result.Hardlinked)
+	require.Contains([]Strategy{Reflink, Copy}, result.Strategy())
+	requireSameTree(t, src, dst)
+	require.False(sameFile(t, filepath.Join(src, "000001.ldb"), filepath.Join(dst, "000001.ldb")))
+}
+
+func TestResultStrategy(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	require.Equal(Copy, Result{}.Strategy())
+	require.Equal(Copy, Result{Copied: 2}.Strategy())
+	require.Equal(Reflink, Result{Reflinked: 2}.Strategy())
+	require.Equal(Hardlink, Result{Hardlinked: 2, Copied: 1}.Strategy())
+	// an immutable file was copied as hardlinking it failed
+	require.Equal(Mixed, Result{Hardlinked: 2, Copied: 1, HardlinkFallbacks: 1}.Strategy())
+	require.Equal(Copy, Result{Copied: 2, HardlinkFallbacks: 1}.Strategy())
+	require.Equal(Mixed, Result{Reflinked: 2, Copied: 1}.Strategy())
+	require.Equal(Mixed, Result{Reflinked: 1, Hardlinked: 1}.Strategy())
+}
@@ -0,0 +1,17 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+//go:build !linux
+
+package fastcopy
+
+import (
+	"errors"
+	"io/fs"
+)
+
+var errReflinkUnsupported = errors.New("reflinks are not supported")
+
+func reflink(string, string, fs.FileMode) error {
+	return errReflinkUnsupported
+}
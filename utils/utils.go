@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"crypto/rsa"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 )
 
 const (
@@ -32,6 +34,43 @@ func ToNodeID(stakingKey, stakingCert []byte) (ids.NodeID, error) {
 	return nodeID, nil
 }
 
+// ToNodeKey returns the secp256k1 key of the node with the given staking key
+// and cert, whose address is the node ID. Fails for staking certs created
+// with another secp256k1 key than the one derived from the staking key.
+func ToNodeKey(stakingKey, stakingCert []byte) (*secp256k1.PrivateKey, error) {
+	cert, err := staking.LoadTLSCertFromBytes(stakingKey, stakingCert)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := cert.PrivateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected rsa staking key, got %T", ErrUnknownNodeKey, cert.PrivateKey)
+	}
+	factory := secp256k1.Factory{}
+	key, err := factory.ToPrivateKey(secp256k1.RsaPrivateKeyToSecp256PrivateKey(rsaKey).Serialize())
+	if err != nil {
+		return nil, err
+	}
+	nodeID, err := peer.CertToID(cert.Leaf)
+	if err != nil {
+		return nil, fmt.Errorf("cannot extract nodeID from certificate: %w", err)
+	}
+	if key.PublicKey().Address() != ids.ShortID(nodeID) {
+		return nil, fmt.Errorf("%w: node %s", ErrUnknownNodeKey, nodeID)
+	}
+	return key, nil
+}
+
+// ParsePrivateKey parses a secp256k1 private key in "PrivateKey-..." format.
+func ParsePrivateKey(keyStr string) (*secp256k1.PrivateKey, error) {
+	key := &secp256k1.PrivateKey{}
+	// expects a JSON string
+	if err := key.UnmarshalText([]byte(`"` + keyStr + `"`)); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
 // Returns the network ID in the given genesis
 func NetworkIDFromGenesis(genesis []byte) (uint32, error) {
 	genesisMap := map[string]interface{}{}
@@ -54,6 +93,7 @@ var (
 	ErrNotExists              = errors.New("camino-node exec not exists")
 	ErrNotExistsPlugin        = errors.New("plugin exec not exists")
 	ErrNotExistsPluginGenesis = errors.New("plugin genesis not exists")
+	ErrUnknownNodeKey         = errors.New("node key can't be derived from the staking key")
 )
 
 func CheckExecPath(exec string) error {
@@ -5,6 +5,10 @@ import (
 	"os"
 	"testing"
 
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/staking"
+	avago_secp256k1 "github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/stretchr/testify/require"
 )
 
@@ -128,3 +132,37 @@ func TestCheckPluginPaths(t *testing.T) {
 		require.Equal(t, tv.expectedErr, err, fmt.Sprintf("[%d] unexpected error", i))
 	}
 }
+
+func TestToNodeKey(t *testing.T) {
+	require := require.New(t)
+
+	cert, key, err := staking.NewCertAndKeyBytes()
+	require.NoError(err)
+	nodeKey, err := ToNodeKey(key, cert)
+	require.NoError(err)
+	nodeID, err := ToNodeID(key, cert)
+	require.NoError(err)
+	require.Equal(ids.ShortID(nodeID), nodeKey.PublicKey().Address())
+
+	// cert created with another secp256k1 key
+	otherKey, err := secp256k1.GeneratePrivateKey()
+	require.NoError(err)
+	cert, key, err = staking.NewCertAndKeyBytesWithSecpKey(otherKey)
+	require.NoError(err)
+	_, err = ToNodeKey(key, cert)
+	require.ErrorIs(err, ErrUnknownNodeKey)
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	require := require.New(t)
+
+	factory := avago_secp256k1.Factory{}
+	key, err := factory.NewPrivateKey()
+	require.NoError(err)
+	parsed, err := ParsePrivateKey(key.String())
+	require.NoError(err)
+	require.Equal(key.Bytes(), parsed.Bytes())
+
+	_, err = ParsePrivateKey(key.String()[len(avago_secp256k1.PrivateKeyPrefix):])
+	require.Error(err)
+}